@@ -7,8 +7,10 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"regexp"
+	"math"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 type State int
@@ -58,17 +60,65 @@ const SGRByte = 'm' // Select Graphic Rendition
 const CUFByte = 'C' // Cursor forward
 const FinalBytes = "@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~)"
 
-type Color struct {
-	N   int
-	RGB []int
+// Color is the default color, a palette index, a 256 color index or a RGB
+// color packed into 32 bits with kind in the top byte. Zero value is the
+// default color.
+type Color uint32
+
+const (
+	ColorDefault Color = 0
+
+	colorKindPalette Color = 1 << 24
+	colorKindRGB     Color = 2 << 24
+	colorKind256     Color = 3 << 24
+	colorKindMask    Color = 0xff << 24
+)
+
+// PaletteColor returns color n of the 16 color palette
+func PaletteColor(n int) Color {
+	return colorKindPalette | Color(n&0xff)
+}
+
+// RGBColor returns a RGB color
+func RGBColor(r, g, b uint8) Color {
+	return colorKindRGB | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+// Palette returns palette index and true if c is a palette color
+func (c Color) Palette() (int, bool) {
+	if c&colorKindMask != colorKindPalette {
+		return 0, false
+	}
+	return int(c & 0xff), true
+}
+
+// Index256 returns 256 color index and true if c is a 256 color above 15,
+// lower indexes are palette colors
+func (c Color) Index256() (int, bool) {
+	if c&colorKindMask != colorKind256 {
+		return 0, false
+	}
+	return int(c & 0xff), true
+}
+
+// RGB returns red, green, blue and true if c is a RGB color or a 256 color above 15
+func (c Color) RGB() (r, g, b uint8, ok bool) {
+	switch c & colorKindMask {
+	case colorKindRGB:
+		return uint8(c >> 16), uint8(c >> 8), uint8(c), true
+	case colorKind256:
+		r, g, b := rgb256(int(c & 0xff))
+		return r, g, b, true
+	}
+	return 0, 0, 0, false
 }
 
 func (c Color) String() string {
-	if len(c.RGB) != 0 {
-		return fmt.Sprintf("#%.2x%.2x%.2x", c.RGB[0], c.RGB[1], c.RGB[2])
+	if r, g, b, ok := c.RGB(); ok {
+		return fmt.Sprintf("#%.2x%.2x%.2x", r, g, b)
 	}
-	if c.N != -1 {
-		return fmt.Sprintf("%d", c.N)
+	if n, ok := c.Palette(); ok {
+		return strconv.Itoa(n)
 	}
 	return ""
 }
@@ -87,6 +137,8 @@ type Decoder struct {
 	Invert        bool
 	Italic        bool
 	Strikethrough bool
+	// Window title set by OSC 0 or 2
+	Title string
 
 	MaxX  int
 	MaxY  int
@@ -97,62 +149,115 @@ type Decoder struct {
 	ny        int
 	readBuf   *bufio.Reader
 	paramsBuf *bytes.Buffer
+	oscBuf    *bytes.Buffer
+	// parsed params of last control sequence, reused
+	params []int
 }
 
 // NewDecoder returns new ANSI decoder that is a io.RuneReader. See ReadRune for details.
 func NewDecoder(r io.Reader) *Decoder {
 	return &Decoder{
-		Foreground: Color{N: -1},
-		Background: Color{N: -1},
-		readBuf:    bufio.NewReader(r),
-		paramsBuf:  &bytes.Buffer{},
+		readBuf:   bufio.NewReader(r),
+		paramsBuf: &bytes.Buffer{},
+		oscBuf:    &bytes.Buffer{},
 	}
 }
 
 func intsToColor(fo int, bo int, cs []int) (Color, int) {
 	if len(cs) == 0 {
-		return Color{N: -1}, 0
+		return ColorDefault, 0
 	}
 	switch {
 	case cs[0] == 2 && len(cs) >= 4: // 2;r;g;b
-		return Color{RGB: append([]int{}, cs[1:4]...)}, 4
+		return RGBColor(uint8(cs[1]), uint8(cs[2]), uint8(cs[3])), 4
 	case cs[0] == 5 && len(cs) >= 2: // 5;n
-		n := cs[1]
+		if c := Color256(cs[1]); c != ColorDefault {
+			return c, 2
+		}
+	}
+	return ColorDefault, 0
+}
+
+// Color256 returns color for 256 color palette index n, 0-15 are palette
+// colors and the rest are 256 colors with RGB. Returns ColorDefault if n is out of range.
+func Color256(n int) Color {
+	switch {
+	case n >= 0 && n <= 15:
+		// 0-  7:  standard colors (as in ESC [ 30–37 m)
+		// 8- 15:  high intensity colors (as in ESC [ 90–97 m)
+		return PaletteColor(n)
+	case n >= 16 && n <= 255:
+		return colorKind256 | Color(n)
+	}
+	return ColorDefault
+}
+
+// rgb256 returns RGB for 256 color palette index n, 16-255
+func rgb256(n int) (r, g, b uint8) {
+	if n >= 232 {
+		// 232-255:  grayscale from black to white in 24 steps
+		g := uint8(255 * ((float32(n) - 232.0) / 23))
+		return g, g, g
+	}
+
+	// 16-231:  6 × 6 × 6 cube (216 colors): 16 + 36 × r + 6 × g + b (0 ≤ r, g, b ≤ 5)
+	// TODO: not tested
+	n -= 16
+	ri := n / 36
+	n %= 36
+	gi := n / 6
+	n %= 6
+	bi := n
+
+	// iterm2 mapping of 0-5 -> 0-255 is 0 -> 0, 1-5 -> n*40+55
+	// https://github.com/gnachman/iTerm2/blob/5fc45c349417b8483dfe8426432fcbadc32cb6d9/sources/NSColor%2BiTerm.m#L335
+	// Is this documented somewhere?
+	f := func(c int) uint8 {
+		if c == 0 {
+			return 0
+		}
+		return uint8(c*40 + 55)
+	}
+	return f(ri), f(gi), f(bi)
+}
+
+// parseParams parses : or ; separated params into d.params, empty and
+// invalid params are 0
+func (d *Decoder) parseParams(bs []byte) []int {
+	pn := append(d.params[:0], 0)
+	invalid := false
+	for _, b := range bs {
+		n := &pn[len(pn)-1]
 		switch {
-		case n >= 0 && n <= 15:
-			// 0-  7:  standard colors (as in ESC [ 30–37 m)
-			// 8- 15:  high intensity colors (as in ESC [ 90–97 m)
-			return Color{N: n}, 2
-		case n >= 16 && n <= 231:
-			// 16-231:  6 × 6 × 6 cube (216 colors): 16 + 36 × r + 6 × g + b (0 ≤ r, g, b ≤ 5)
-			// TODO: not tested
-			n -= 16
-			r := n / 36
-			n %= 36
-			g := n / 6
-			n %= 6
-			b := n
-
-			// iterm2 mapping of 0-5 -> 0-255 is 0 -> 0, 1-5 -> n*40+55
-			// https://github.com/gnachman/iTerm2/blob/5fc45c349417b8483dfe8426432fcbadc32cb6d9/sources/NSColor%2BiTerm.m#L335
-			// Is this documented somewhere?
-			f := func(c int) int {
-				if c == 0 {
-					return 0
-				}
-				return c*40 + 55
-			}
-			return Color{RGB: []int{f(r), f(g), f(b)}}, 2
-		case n >= 232 && n <= 255:
-			// 232-255:  grayscale from black to white in 24 steps
-			g := int(255 * ((float32(n) - 232.0) / 23))
-			return Color{RGB: []int{g, g, g}}, 2
+		case b == ':' || b == ';':
+			pn = append(pn, 0)
+			invalid = false
+		case invalid:
+		case b >= '0' && b <= '9' && *n <= math.MaxInt32/10:
+			*n = *n*10 + int(b-'0')
+		default:
+			// same as strconv.Atoi failing
+			*n = 0
+			invalid = true
 		}
 	}
-	return Color{N: -1}, 0
+	d.params = pn
+	return pn
 }
 
-var paramSplitRE = regexp.MustCompile(`[:;]`)
+// maxOSCLen is max length of a buffered OSC sequence, the rest is dropped so
+// that an unterminated sequence does not buffer until end of input
+const maxOSCLen = 4096
+
+// handleOSC handles a complete OSC sequence, currently only window title
+func (d *Decoder) handleOSC() {
+	ps, pt, _ := bytes.Cut(d.oscBuf.Bytes(), []byte(";"))
+	switch string(ps) {
+	case "0", "2": // icon name and window title, window title
+		d.Title = string(pt)
+	}
+	d.oscBuf.Reset()
+}
 
 // ReadRune returns next rune. The decoder struct has state for last returned rune, .X, .Y, .Foreground etc.
 func (d *Decoder) ReadRune() (r rune, size int, err error) {
@@ -208,15 +313,8 @@ func (d *Decoder) ReadRune() (r rune, size int, err error) {
 			}
 		case StateCSI:
 			switch {
-			case bytes.ContainsAny([]byte(string([]rune{r})), FinalBytes):
-				s := d.paramsBuf.String()
-				ss := paramSplitRE.Split(s, -1)
-				var pn []int
-				for _, p := range ss {
-					// will treat empty as 0
-					n, _ := strconv.Atoi(p)
-					pn = append(pn, n)
-				}
+			case strings.ContainsRune(FinalBytes, r):
+				pn := d.parseParams(d.paramsBuf.Bytes())
 				d.paramsBuf.Reset()
 
 				switch r {
@@ -226,8 +324,8 @@ func (d *Decoder) ReadRune() (r rune, size int, err error) {
 						var ns int
 						switch {
 						case sgrReset.Is(n):
-							d.Foreground = Color{N: -1}
-							d.Background = Color{N: -1}
+							d.Foreground = ColorDefault
+							d.Background = ColorDefault
 							d.Underline = false
 							d.Intensity = false
 							d.Dim = false
@@ -242,23 +340,23 @@ func (d *Decoder) ReadRune() (r rune, size int, err error) {
 						case sgrDim.Is(n):
 							d.Dim = true
 						case sgrForeground.Is(n):
-							d.Foreground = Color{N: n - 30}
+							d.Foreground = PaletteColor(n - 30)
 						case sgrForegroundBright.Is(n):
-							d.Foreground = Color{N: n - 90 + 8}
+							d.Foreground = PaletteColor(n - 90 + 8)
 						case sgrForegroundRGB.Is(n):
 							d.Foreground, ns = intsToColor(30, 90, pn[i+1:])
 							i += ns
 						case sgrForegroundDefault.Is(n):
-							d.Foreground = Color{N: -1}
+							d.Foreground = ColorDefault
 						case sgrBackground.Is(n):
-							d.Background = Color{N: n - 40}
+							d.Background = PaletteColor(n - 40)
 						case sgrBackgroundBright.Is(n):
-							d.Background = Color{N: n - 100 + 8}
+							d.Background = PaletteColor(n - 100 + 8)
 						case sgrBackgroundRGB.Is(n):
 							d.Background, ns = intsToColor(40, 100, pn[i+1:])
 							i += ns
 						case sgrBackgroundDefault.Is(n):
-							d.Background = Color{N: -1}
+							d.Background = ColorDefault
 						case sgrUnderlineOn.Is(n):
 							d.Underline = true
 						case sgrUnderlineOff.Is(n):
@@ -296,15 +394,22 @@ func (d *Decoder) ReadRune() (r rune, size int, err error) {
 		case StateOSC:
 			switch r {
 			case BELRune:
+				d.handleOSC()
 				d.State = StateCopy
 			case ESCRune:
 				d.State = StateOSCSeenESC
 			default:
-				// nop, skip
+				if d.oscBuf.Len()+utf8.RuneLen(r) > maxOSCLen {
+					continue
+				}
+				if _, err := d.oscBuf.WriteRune(r); err != nil {
+					return 0, 0, err
+				}
 			}
 		case StateOSCSeenESC:
 			switch r {
 			case '\\':
+				d.handleOSC()
 				d.State = StateCopy
 			default:
 				// nop, skip
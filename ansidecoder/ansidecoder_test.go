@@ -0,0 +1,49 @@
+package ansidecoder
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func readAll(t *testing.T, d *Decoder) string {
+	t.Helper()
+	var sb strings.Builder
+	for {
+		r, _, err := d.ReadRune()
+		if err == io.EOF {
+			return sb.String()
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		sb.WriteRune(r)
+	}
+}
+
+func TestOSCTitle(t *testing.T) {
+	long := strings.Repeat("å", maxOSCLen)
+	for _, tc := range []struct {
+		input    string
+		title    string
+		expected string
+	}{
+		{input: "\x1b]0;title\x07a", title: "title", expected: "a"},
+		{input: "\x1b]2;title\x1b\\a", title: "title", expected: "a"},
+		{input: "\x1b]1;icon\x07a", title: "", expected: "a"},
+		// excess is dropped, "0;" and whole runes fit
+		{input: "\x1b]0;" + long + "\x07a", title: long[0 : maxOSCLen-2], expected: "a"},
+		// unterminated
+		{input: "\x1b]0;" + long, title: "", expected: ""},
+	} {
+		d := NewDecoder(strings.NewReader(tc.input))
+		if actual := readAll(t, d); actual != tc.expected {
+			t.Errorf("expected text %q, got %q", tc.expected, actual)
+		}
+		if d.Title != tc.title {
+			t.Errorf("expected title of length %d, got %d", len(tc.title), len(d.Title))
+		}
+		if d.oscBuf.Len() > maxOSCLen {
+			t.Errorf("expected at most %d buffered bytes, got %d", maxOSCLen, d.oscBuf.Len())
+		}
+	}
+}
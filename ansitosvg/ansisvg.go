@@ -2,30 +2,111 @@
 package ansitosvg
 
 import (
+	"compress/gzip"
+	"fmt"
+	"image"
+	"image/png"
 	"io"
 
 	"github.com/wader/ansisvg/ansidecoder"
+	"github.com/wader/ansisvg/colorscheme"
 	"github.com/wader/ansisvg/colorscheme/schemes"
+	"github.com/wader/ansisvg/sfnt"
 	"github.com/wader/ansisvg/svgscreen"
 	"github.com/wader/ansisvg/svgscreen/xydim"
 )
 
 type Options struct {
-	FontName      string
-	FontEmbedded  []byte
-	FontRef       string
+	FontName     string
+	FontEmbedded []byte
+	// Subset TrueType FontEmbedded to only glyphs used before embedding
+	FontSubset bool
+	// Use pixel cell size, baseline and underline position from FontEmbedded
+	// metrics instead of font-relative units, ignored if CharBoxSize is set
+	FontMetrics bool
+	FontRef     string
+	// Bold, italic and bold italic variants of FontEmbedded or FontRef, styles
+	// without a font are synthesized by the viewer
+	FontBoldEmbedded       []byte
+	FontItalicEmbedded     []byte
+	FontBoldItalicEmbedded []byte
+	FontBoldRef            string
+	FontItalicRef          string
+	FontBoldItalicRef      string
+	// Fake bold with a stroke of the fill color if there is no bold font
+	FontFakeBold  bool
 	FontSize      int
 	TerminalWidth int
 	LineWrap      bool
 	CharBoxSize   xydim.XyDimInt
 	MarginSize    xydim.XyDimFloat
 	ColorScheme   string
-	Transparent   bool
-	GridMode      bool
-	FillOnly      bool
-	LineHeight    float32
+	// CustomColorScheme is used instead of ColorScheme if set
+	CustomColorScheme *colorscheme.WorkbenchColorCustomizations
+	// DarkColorScheme is used when viewer prefers a dark color scheme, empty disables
+	DarkColorScheme string
+	// CustomDarkColorScheme is used instead of DarkColorScheme if set
+	CustomDarkColorScheme *colorscheme.WorkbenchColorCustomizations
+	// ColorOverrides replaces individual colors of the color scheme and dark color scheme
+	ColorOverrides colorscheme.Overrides
+	Transparent    bool
+	GridMode       bool
+	// Position each styled run and set length of non-ASCII runs (see svgscreen.Screen.HybridGridMode)
+	HybridGridMode bool
+	// Draw box drawing, block element and Powerline chars filling the cell
+	BoxDrawing bool
+	// Draw half block, quadrant, sextant and braille chars as merged pixel rects
+	PixelArt bool
+	// Clip each text run to its cells, icons can overflow into a following space
+	ClipCells bool
+	// Optimize SVG for size, see svgscreen.Screen.Optimize
+	Optimize bool
+	// Gzip compress SVG output (.svgz)
+	SVGZ bool
+	// Render SVG line by line with memory use independent of input size, see
+	// svgscreen.LineWriter. Not supported with other formats, text to path,
+	// box drawing, pixel art, clip cells and accessible without Description.
+	Stream     bool
+	FillOnly   bool
+	LineHeight float32
+	// Minimum WCAG contrast ratio (1-21) between foreground and background, 0 disables
+	MinimumContrastRatio float64
+	// Use CSS variables with color scheme as fallback for scheme colors and dim opacity
+	CSSVariables bool
+	// Render text as glyph outline paths from FontEmbedded, SVG output only
+	TextToPath bool
+	// Output format, FormatSVG (default), FormatHTML, FormatPNG, FormatPDF, FormatJSON,
+	// FormatText or FormatANSI
+	Format string
+	// HTML output options
+	HTMLFragment     bool
+	HTMLInlineStyles bool
+	// Image output scale, ex 2 for HiDPI displays, at least 1 for FormatPNG
+	ImageScale int
+	// PDF page width in points, 0 uses 1px as 0.75pt
+	PDFPageWidth float64
+	// Color mode for ANSI output, see svgscreen.ColorModeTrueColor etc
+	ANSIColorMode string
+	// Number of columns for gallery output
+	GalleryColumns int
+	// Title and Description of the image, see Accessible for defaults
+	Title       string
+	Description string
+	// Accessible makes SVG an accessible image, Title defaults to window title
+	// set by OSC 0 or 2 and Description to plain text content
+	Accessible bool
 }
 
+const (
+	FormatSVG  = "svg"
+	FormatHTML = "html"
+	FormatPNG  = "png"
+	FormatPDF  = "pdf"
+	FormatJSON = "json"
+	FormatText = "text"
+	FormatANSI = "ansi"
+)
+
 var DefaultOptions = Options{
 	FontName:    "Courier",
 	FontSize:    14,
@@ -35,36 +116,146 @@ var DefaultOptions = Options{
 	Transparent: false,
 	FillOnly:    false,
 	LineHeight:  1.0,
+	Format:      FormatSVG,
+	FontSubset:  true,
+	FontMetrics: true,
+
+	GalleryColumns: 3,
+	ImageScale:     1,
+	ANSIColorMode:  svgscreen.ColorModeTrueColor,
 }
 
-// Convert reads ANSI input from r and writes SVG to w
-func Convert(r io.Reader, w io.Writer, opts Options) error {
+func loadColorScheme(name string, custom *colorscheme.WorkbenchColorCustomizations, overrides colorscheme.Overrides) (colorscheme.WorkbenchColorCustomizations, error) {
+	var colorScheme colorscheme.WorkbenchColorCustomizations
+	if custom != nil {
+		colorScheme = *custom
+	} else {
+		var err error
+		colorScheme, err = schemes.Load(name)
+		if err != nil {
+			return colorScheme, err
+		}
+	}
+	if err := colorScheme.Normalize(); err != nil {
+		return colorScheme, fmt.Errorf("color scheme: %w", err)
+	}
+	return overrides.Apply(colorScheme)
+}
+
+// decoded is the result of decoding ANSI input
+type decoded struct {
+	lines         []svgscreen.Line
+	terminalWidth int
+	columns       int
+	nrLines       int
+	// window title set by OSC sequence
+	title string
+	// runes used by all chars and by bold, italic and bold italic chars
+	runes           runeSet
+	boldRunes       runeSet
+	italicRunes     runeSet
+	boldItalicRunes runeSet
+}
+
+// runeSet is a set of runes in order of first use
+type runeSet struct {
+	seen  map[rune]bool
+	runes []rune
+}
+
+func (rs *runeSet) add(r rune) {
+	if rs.seen[r] {
+		return
+	}
+	if rs.seen == nil {
+		rs.seen = map[rune]bool{}
+	}
+	rs.seen[r] = true
+	rs.runes = append(rs.runes, r)
+}
+
+// screenColor converts decoded color to screen color
+func screenColor(c ansidecoder.Color) svgscreen.Color {
+	if n, ok := c.Palette(); ok {
+		return svgscreen.ANSIColor(n)
+	}
+	if n, ok := c.Index256(); ok {
+		return svgscreen.Color256(n)
+	}
+	if r, g, b, ok := c.RGB(); ok {
+		return svgscreen.RGBColor(r, g, b)
+	}
+	return svgscreen.ColorDefault
+}
+
+func decode(r io.Reader, opts Options) (decoded, error) {
+	var lines []svgscreen.Line
+	d, err := decodeLines(r, opts, func(l svgscreen.Line) error {
+		lines = append(lines, l)
+		return nil
+	})
+	d.lines = lines
+	return d, err
+}
+
+// decodeLines decodes ANSI input and calls fn with each line when it is
+// complete, lines of the result is not set
+func decodeLines(r io.Reader, opts Options, fn func(l svgscreen.Line) error) (decoded, error) {
+	var d decoded
+	addLine := func(l svgscreen.Line) error {
+		for _, c := range l.Chars {
+			d.runes.add(c.Rune)
+			if c.Attr.Has(svgscreen.AttrIntensity) {
+				d.boldRunes.add(c.Rune)
+			}
+			if c.Attr.Has(svgscreen.AttrItalic) {
+				d.italicRunes.add(c.Rune)
+			}
+			if c.Attr.Has(svgscreen.AttrIntensity | svgscreen.AttrItalic) {
+				d.boldItalicRunes.add(c.Rune)
+			}
+		}
+		return fn(l)
+	}
+
 	ad := ansidecoder.NewDecoder(r)
 
 	ad.TerminalWidth = opts.TerminalWidth
 	ad.LineWrap = opts.LineWrap
 
 	lineNr := 0
-	var lines []svgscreen.Line
 	line := svgscreen.Line{
 		Y: lineNr,
 	}
 
-	lastX := 0
 	lastY := 0
 
+	// put sets char at column x, chars are indexed by column and a char at
+	// an existing column overdraws, ex after a carriage return
+	put := func(x int, c svgscreen.Char) {
+		for len(line.Chars) < x {
+			line.Chars = append(line.Chars, svgscreen.Char{Rune: ' '})
+		}
+		if x < len(line.Chars) {
+			line.Chars[x] = c
+			return
+		}
+		line.Chars = append(line.Chars, c)
+	}
+
 	for {
 		r, _, err := ad.ReadRune()
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return err
+			return decoded{}, err
 		}
 
 		if lastY != ad.Y {
 			lastY = ad.Y
-			lastX = 0
-			lines = append(lines, line)
+			if err := addLine(line); err != nil {
+				return decoded{}, err
+			}
 			lineNr++
 			line = svgscreen.Line{
 				Y: lineNr,
@@ -72,51 +263,89 @@ func Convert(r io.Reader, w io.Writer, opts Options) error {
 		}
 
 		n := 1
-		if r == '\n' {
-			lastX = 0
+		tab := false
+		if r == '\n' || r == '\r' {
+			// cursor movement only, a \r in text would end up as a line break when copied
 			continue
 		} else if r == '\t' {
-			// normalize tab into spaces
+			// normalize tab into spaces, over existing chars it only moves the cursor
 			r = ' '
 			n = 8 - (ad.X % 8)
-		} else {
-			d := ad.X - lastX
-			for i := 0; i < d; i++ {
-				line.Chars = append(line.Chars, svgscreen.Char{
-					Char: string([]rune{' '}),
-					X:    ad.X + i,
-				})
-			}
+			tab = true
 		}
 
+		var attr svgscreen.Attr
+		for _, a := range [...]struct {
+			on   bool
+			attr svgscreen.Attr
+		}{
+			{ad.Intensity, svgscreen.AttrIntensity},
+			{ad.Dim, svgscreen.AttrDim},
+			{ad.Italic, svgscreen.AttrItalic},
+			{ad.Underline, svgscreen.AttrUnderline},
+			{ad.Strikethrough, svgscreen.AttrStrikethrough},
+			{ad.Invert, svgscreen.AttrInvert},
+		} {
+			if a.on {
+				attr |= a.attr
+			}
+		}
+		c := svgscreen.Char{
+			Rune:       r,
+			Foreground: screenColor(ad.Foreground),
+			Background: screenColor(ad.Background),
+			Attr:       attr,
+		}
 		for i := 0; i < n; i++ {
-			line.Chars = append(line.Chars, svgscreen.Char{
-				Char:          string([]rune{r}),
-				X:             ad.X + i,
-				Foreground:    ad.Foreground.String(),
-				Background:    ad.Background.String(),
-				Underline:     ad.Underline,
-				Intensity:     ad.Intensity,
-				Dim:           ad.Dim,
-				Invert:        ad.Invert,
-				Italic:        ad.Italic,
-				Strikethrough: ad.Strikethrough,
-			})
-		}
-		lastX = ad.X + n
+			if tab && ad.X+i < len(line.Chars) {
+				continue
+			}
+			put(ad.X+i, c)
+		}
 	}
 	if len(line.Chars) > 0 {
-		lines = append(lines, line)
+		if err := addLine(line); err != nil {
+			return decoded{}, err
+		}
 	}
-	terminalWidth := ad.MaxX + 1
+	d.terminalWidth = ad.MaxX + 1
 	if opts.TerminalWidth != 0 {
-		terminalWidth = opts.TerminalWidth
+		d.terminalWidth = opts.TerminalWidth
 	}
-	colorScheme, err := schemes.Load(opts.ColorScheme)
+	d.columns = ad.MaxX + 1
+	d.nrLines = ad.MaxY + 1
+	d.title = ad.Title
+
+	return d, nil
+}
+
+// copyLines returns a deep copy of lines as rendering modifies chars
+func copyLines(lines []svgscreen.Line) []svgscreen.Line {
+	cls := make([]svgscreen.Line, len(lines))
+	for i, l := range lines {
+		cls[i] = svgscreen.Line{
+			Y:     l.Y,
+			Chars: append([]svgscreen.Char{}, l.Chars...),
+		}
+	}
+	return cls
+}
+
+// subsetFont returns font with only glyphs for runes, or font as is if it
+// can't be subset, ex WOFF or CFF fonts
+func subsetFont(font []byte, runes []rune) []byte {
+	f, err := sfnt.Parse(font)
 	if err != nil {
-		return err
+		return font
 	}
+	b, err := f.Subset(runes)
+	if err != nil {
+		return font
+	}
+	return b
+}
 
+func newScreen(d decoded, c colorscheme.WorkbenchColorCustomizations, opts Options) *svgscreen.Screen {
 	fontName := opts.FontName
 	if len(opts.FontEmbedded) > 0 {
 		fontName = "Embedded"
@@ -124,8 +353,7 @@ func Convert(r io.Reader, w io.Writer, opts Options) error {
 		fontName = "ExternalRef"
 	}
 
-	c := colorScheme
-	s := svgscreen.Screen{
+	s := &svgscreen.Screen{
 		Transparent: opts.Transparent,
 		Foreground: svgscreen.ColorMap{
 			Default: c.Foreground,
@@ -133,39 +361,288 @@ func Convert(r io.Reader, w io.Writer, opts Options) error {
 		Background: svgscreen.ColorMap{
 			Default: c.Background,
 		},
-		ANSIColors: [16]string{
-			c.ANSIBlack,
-			c.ANSIRed,
-			c.ANSIGreen,
-			c.ANSIYellow,
-			c.ANSIBlue,
-			c.ANSIMagenta,
-			c.ANSICyan,
-			c.ANSIWhite,
-			c.ANSIBrightBlack,
-			c.ANSIBrightRed,
-			c.ANSIBrightGreen,
-			c.ANSIBrightYellow,
-			c.ANSIBrightBlue,
-			c.ANSIBrightMagenta,
-			c.ANSIBrightCyan,
-			c.ANSIBrightWhite,
-		},
+		ANSIColors: c.ANSIColors(),
 		Dom: svgscreen.SvgDom{
-			FontName:     fontName,
-			FontEmbedded: opts.FontEmbedded,
-			FontRef:      opts.FontRef,
-			FontSize:     opts.FontSize,
+			FontName: fontName,
+			FontRef:  opts.FontRef,
+			FakeBold: opts.FontFakeBold,
+			FontSize: opts.FontSize,
 		},
-		CharacterBoxSize: opts.CharBoxSize,
-		MarginSize:       opts.MarginSize,
-		LineHeight:       opts.LineHeight,
-		TerminalWidth:    terminalWidth,
-		Columns:          ad.MaxX + 1,
-		NrLines:          ad.MaxY + 1,
-		Lines:            lines,
-		GridMode:         opts.GridMode,
-		FillOnly:         opts.FillOnly,
+		CharacterBoxSize:     opts.CharBoxSize,
+		MarginSize:           opts.MarginSize,
+		LineHeight:           opts.LineHeight,
+		GridMode:             opts.GridMode,
+		HybridGridMode:       opts.HybridGridMode,
+		BoxDrawing:           opts.BoxDrawing,
+		PixelArt:             opts.PixelArt,
+		ClipCells:            opts.ClipCells,
+		Optimize:             opts.Optimize,
+		FillOnly:             opts.FillOnly,
+		MinimumContrastRatio: opts.MinimumContrastRatio,
+		CSSVariables:         opts.CSSVariables,
+	}
+	if opts.FontMetrics && len(opts.FontEmbedded) > 0 {
+		// WOFF and other fonts that can't be parsed keeps font-relative units
+		if f, err := sfnt.Parse(opts.FontEmbedded); err == nil {
+			s.UseFontMetrics(f)
+		}
+	}
+	setContent(s, d, opts)
+
+	return s
+}
+
+// setContent sets lines, size, fonts subset to used chars, title and
+// description of screen s from decoded input
+func setContent(s *svgscreen.Screen, d decoded, opts Options) {
+	s.TerminalWidth = d.terminalWidth
+	s.Columns = d.columns
+	s.NrLines = d.nrLines
+	s.Lines = d.lines
+
+	s.Dom.FontEmbedded = opts.FontEmbedded
+	if opts.FontSubset && len(opts.FontEmbedded) > 0 {
+		s.Dom.FontEmbedded = subsetFont(opts.FontEmbedded, d.runes.runes)
+	}
+	// style variant fonts are only embedded if used and subset to chars with the style
+	fontFace := func(embedded []byte, ref string, runes runeSet) svgscreen.FontFace {
+		if len(embedded) == 0 {
+			return svgscreen.FontFace{Ref: ref}
+		}
+		if len(runes.runes) == 0 {
+			return svgscreen.FontFace{}
+		}
+		if opts.FontSubset {
+			embedded = subsetFont(embedded, runes.runes)
+		}
+		return svgscreen.FontFace{Embedded: embedded}
+	}
+	s.Dom.FontBold = fontFace(opts.FontBoldEmbedded, opts.FontBoldRef, d.boldRunes)
+	s.Dom.FontItalic = fontFace(opts.FontItalicEmbedded, opts.FontItalicRef, d.italicRunes)
+	s.Dom.FontBoldItalic = fontFace(opts.FontBoldItalicEmbedded, opts.FontBoldItalicRef, d.boldItalicRunes)
+
+	s.Dom.Title = opts.Title
+	s.Dom.Description = opts.Description
+	if opts.Accessible {
+		if s.Dom.Title == "" {
+			s.Dom.Title = d.title
+		}
+		if s.Dom.Description == "" {
+			s.Dom.Description = svgscreen.PlainText(d.lines)
+		}
+	}
+}
+
+// ConvertImage reads ANSI input from r and renders an image. Uses opts.FontEmbedded
+// if it is a TrueType font otherwise a built-in bitmap font. Fails if the image
+// would be larger than svgscreen.MaxImagePixels.
+func ConvertImage(r io.Reader, opts Options) (*image.RGBA, error) {
+	if err := checkOptions(opts); err != nil {
+		return nil, err
+	}
+	if opts.ImageScale < 1 {
+		return nil, fmt.Errorf("scale must be at least 1")
+	}
+	colorScheme, err := loadColorScheme(opts.ColorScheme, opts.CustomColorScheme, opts.ColorOverrides)
+	if err != nil {
+		return nil, err
+	}
+	var font *sfnt.Font
+	if len(opts.FontEmbedded) > 0 {
+		if font, err = sfnt.Parse(opts.FontEmbedded); err != nil {
+			return nil, fmt.Errorf("font: %w", err)
+		}
+	}
+
+	d, err := decode(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newScreen(d, colorScheme, opts)
+	s.CSSVariables = false
+	return s.Image(svgscreen.ImageOptions{
+		Font:  font,
+		Scale: opts.ImageScale,
+	})
+}
+
+// usesVariables returns true if scheme colors in output are CSS variables.
+// Their values depend on the viewer so contrast can't be enforced.
+func usesVariables(opts Options) bool {
+	switch opts.Format {
+	case "", FormatSVG, FormatHTML:
+		return opts.CSSVariables || opts.DarkColorScheme != "" || opts.CustomDarkColorScheme != nil
+	}
+	return false
+}
+
+// checkOptions returns error if an option value is out of range
+func checkOptions(opts Options) error {
+	if opts.FontSize <= 0 {
+		return fmt.Errorf("fontsize must be greater than 0")
+	}
+	if opts.LineHeight <= 0 {
+		return fmt.Errorf("lineheight must be greater than 0")
+	}
+	if opts.MinimumContrastRatio != 0 && (opts.MinimumContrastRatio < 1 || opts.MinimumContrastRatio > 21) {
+		return fmt.Errorf("mincontrast must be between 1 and 21")
+	}
+	return nil
+}
+
+// Convert reads ANSI input from r and writes SVG, HTML, PNG, PDF, JSON, plain text or
+// normalized ANSI depending on opts.Format to w
+func Convert(r io.Reader, w io.Writer, opts Options) error {
+	if err := checkOptions(opts); err != nil {
+		return err
+	}
+	if opts.Format != "" && opts.Format != FormatSVG {
+		switch {
+		case opts.SVGZ:
+			return fmt.Errorf("svgz: %s output not supported", opts.Format)
+		case opts.Optimize:
+			return fmt.Errorf("optimize: %s output not supported", opts.Format)
+		}
+	}
+	switch opts.Format {
+	case "", FormatSVG, FormatHTML, FormatPDF, FormatJSON, FormatText, FormatANSI:
+	case FormatPNG:
+		img, err := ConvertImage(r, opts)
+		if err != nil {
+			return err
+		}
+		return png.Encode(w, img)
+	default:
+		return fmt.Errorf("%s: unsupported format", opts.Format)
+	}
+
+	hasVariant := len(opts.FontBoldEmbedded) > 0 || len(opts.FontItalicEmbedded) > 0 || len(opts.FontBoldItalicEmbedded) > 0 ||
+		opts.FontBoldRef != "" || opts.FontItalicRef != "" || opts.FontBoldItalicRef != ""
+	if hasVariant && len(opts.FontEmbedded) == 0 && opts.FontRef == "" {
+		return fmt.Errorf("bold and italic fonts require a regular embedded font or font ref")
+	}
+
+	if opts.FontFakeBold && opts.FillOnly {
+		return fmt.Errorf("fakebold can't be used with fillonly")
+	}
+	if opts.MinimumContrastRatio > 0 && usesVariables(opts) {
+		return fmt.Errorf("mincontrast can't be used with CSS variables or a dark color scheme")
+	}
+
+	colorScheme, err := loadColorScheme(opts.ColorScheme, opts.CustomColorScheme, opts.ColorOverrides)
+	if err != nil {
+		return err
+	}
+	var darkPalette *svgscreen.Palette
+	if opts.DarkColorScheme != "" || opts.CustomDarkColorScheme != nil {
+		darkColorScheme, err := loadColorScheme(opts.DarkColorScheme, opts.CustomDarkColorScheme, opts.ColorOverrides)
+		if err != nil {
+			return err
+		}
+		darkPalette = &svgscreen.Palette{
+			Foreground: darkColorScheme.Foreground,
+			Background: darkColorScheme.Background,
+			ANSIColors: darkColorScheme.ANSIColors(),
+		}
+	}
+
+	if opts.Stream {
+		return convertStream(r, w, colorScheme, darkPalette, opts)
+	}
+
+	d, err := decode(r, opts)
+	if err != nil {
+		return err
+	}
+
+	s := newScreen(d, colorScheme, opts)
+	switch opts.Format {
+	case FormatHTML:
+		s.Dark = darkPalette
+		return s.RenderHTML(w, svgscreen.HTMLOptions{
+			Fragment:     opts.HTMLFragment,
+			InlineStyles: opts.HTMLInlineStyles,
+		})
+	case FormatText:
+		return s.RenderText(w)
+	case FormatANSI:
+		return s.RenderANSI(w, svgscreen.ANSIOptions{ColorMode: opts.ANSIColorMode})
+	case FormatJSON:
+		if s.Dom.Title == "" {
+			s.Dom.Title = d.title
+		}
+		return s.RenderJSON(w)
+	case FormatPDF:
+		s.CSSVariables = false
+		pdfOpts := svgscreen.PDFOptions{PageWidth: opts.PDFPageWidth}
+		if len(s.Dom.FontEmbedded) > 0 {
+			if pdfOpts.Font, err = sfnt.Parse(s.Dom.FontEmbedded); err != nil {
+				return fmt.Errorf("font: %w", err)
+			}
+			pdfOpts.FontData = s.Dom.FontEmbedded
+		}
+		return s.RenderPDF(w, pdfOpts)
+	}
+	if opts.TextToPath {
+		if len(opts.FontEmbedded) == 0 {
+			return fmt.Errorf("text to path requires an embedded font")
+		}
+		f, err := sfnt.Parse(opts.FontEmbedded)
+		if err != nil {
+			return fmt.Errorf("font: %w", err)
+		}
+		if f.CFF {
+			return fmt.Errorf("font: text to path %w", sfnt.ErrCFFNotSupported)
+		}
+		s.GlyphFont = f
+	}
+	s.Dark = darkPalette
+	if opts.SVGZ {
+		return writeGzip(w, s.Render)
 	}
 	return s.Render(w)
 }
+
+// convertStream reads ANSI input from r and writes SVG to w line by line
+func convertStream(r io.Reader, w io.Writer, c colorscheme.WorkbenchColorCustomizations, darkPalette *svgscreen.Palette, opts Options) error {
+	switch {
+	case opts.Format != "" && opts.Format != FormatSVG:
+		return fmt.Errorf("stream: %s output not supported", opts.Format)
+	case opts.TextToPath:
+		return fmt.Errorf("stream: text to path not supported")
+	case opts.Accessible && opts.Description == "":
+		return fmt.Errorf("stream: accessible output requires a description")
+	}
+
+	s := newScreen(decoded{}, c, opts)
+	s.Dark = darkPalette
+	lw, err := s.NewLineWriter()
+	if err != nil {
+		return err
+	}
+	defer lw.Close()
+
+	d, err := decodeLines(r, opts, lw.WriteLine)
+	if err != nil {
+		return err
+	}
+	// size, fonts and title are known after the last line
+	setContent(s, d, opts)
+	if opts.SVGZ {
+		return writeGzip(w, lw.Render)
+	}
+	return lw.Render(w)
+}
+
+// writeGzip writes gzip compressed output of fn to w
+func writeGzip(w io.Writer, fn func(w io.Writer) error) error {
+	zw, err := gzip.NewWriterLevel(w, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if err := fn(zw); err != nil {
+		return err
+	}
+	return zw.Close()
+}
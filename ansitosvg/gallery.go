@@ -0,0 +1,99 @@
+package ansitosvg
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/wader/ansisvg/colorscheme"
+	"github.com/wader/ansisvg/svgscreen"
+)
+
+// NamedColorScheme is a color scheme with a name used as label
+type NamedColorScheme struct {
+	Name        string
+	ColorScheme colorscheme.WorkbenchColorCustomizations
+}
+
+// ConvertGallery reads ANSI input from r once and writes a gallery with one
+// labeled tile per color scheme to w. Output is SVG or a HTML page if opts.Format is FormatHTML.
+// opts.ColorScheme and opts.CustomColorScheme are ignored but opts.ColorOverrides are applied
+// to each color scheme. Options that only apply to a single screen, ex dark color scheme and
+// title, are not supported.
+func ConvertGallery(r io.Reader, w io.Writer, opts Options, colorSchemes []NamedColorScheme) error {
+	if err := checkOptions(opts); err != nil {
+		return err
+	}
+	switch {
+	case opts.Stream:
+		return fmt.Errorf("stream: gallery not supported")
+	case opts.Optimize:
+		return fmt.Errorf("optimize: gallery not supported")
+	case opts.SVGZ && opts.Format == FormatHTML:
+		return fmt.Errorf("svgz: %s output not supported", opts.Format)
+	case opts.TextToPath:
+		return fmt.Errorf("text to path: gallery not supported")
+	case opts.DarkColorScheme != "" || opts.CustomDarkColorScheme != nil:
+		return fmt.Errorf("dark color scheme: gallery not supported")
+	case opts.Title != "" || opts.Description != "" || opts.Accessible:
+		return fmt.Errorf("title, description and accessible: gallery not supported")
+	case opts.HTMLFragment || opts.HTMLInlineStyles:
+		return fmt.Errorf("fragment and inline styles: gallery not supported")
+	case opts.GalleryColumns < 1:
+		return fmt.Errorf("gallery columns must be at least 1")
+	}
+	html := false
+	switch opts.Format {
+	case "", FormatSVG:
+	case FormatHTML:
+		html = true
+	default:
+		return fmt.Errorf("%s: unsupported gallery format", opts.Format)
+	}
+	if opts.FontFakeBold && opts.FillOnly {
+		return fmt.Errorf("fakebold can't be used with fillonly")
+	}
+	if opts.MinimumContrastRatio > 0 && opts.CSSVariables {
+		return fmt.Errorf("mincontrast can't be used with CSS variables or a dark color scheme")
+	}
+
+	var tileSchemes []colorscheme.WorkbenchColorCustomizations
+	for _, ncs := range colorSchemes {
+		cs, err := opts.ColorOverrides.Apply(ncs.ColorScheme)
+		if err != nil {
+			return err
+		}
+		tileSchemes = append(tileSchemes, cs)
+	}
+
+	d, err := decode(r, opts)
+	if err != nil {
+		return err
+	}
+
+	var tiles []svgscreen.GalleryTile
+	for i, cs := range tileSchemes {
+		td := d
+		td.lines = copyLines(d.lines)
+		s := newScreen(td, cs, opts)
+		// @font-face is global for the whole document so only embed once
+		if i > 0 {
+			s.Dom.FontEmbedded = nil
+			s.Dom.FontRef = ""
+			s.Dom.FontBold = svgscreen.FontFace{}
+			s.Dom.FontItalic = svgscreen.FontFace{}
+			s.Dom.FontBoldItalic = svgscreen.FontFace{}
+		}
+		tiles = append(tiles, svgscreen.GalleryTile{
+			Label:  colorSchemes[i].Name,
+			Screen: s,
+		})
+	}
+
+	render := func(w io.Writer) error {
+		return svgscreen.RenderGallery(w, tiles, opts.GalleryColumns, html)
+	}
+	if opts.SVGZ {
+		return writeGzip(w, render)
+	}
+	return render(w)
+}
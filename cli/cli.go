@@ -1,12 +1,20 @@
 package cli
 
 import (
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/wader/ansisvg/ansitosvg"
+	"github.com/wader/ansisvg/color"
+	"github.com/wader/ansisvg/colorscheme"
 	"github.com/wader/ansisvg/colorscheme/schemes"
 )
 
@@ -19,25 +27,224 @@ type Env struct {
 	Args     []string
 }
 
+// lengthFlag is a physical length in points, units pt, mm, cm, in or px (CSS px, 0.75pt)
+type lengthFlag float64
+
+var lengthUnits = map[string]float64{
+	"pt": 1,
+	"mm": 72 / 25.4,
+	"cm": 72 / 2.54,
+	"in": 72,
+	"px": 0.75,
+}
+
+func (f lengthFlag) String() string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(float64(f), 'g', -1, 64) + "pt"
+}
+
+func (f *lengthFlag) Set(s string) error {
+	s = strings.TrimSpace(s)
+	for u, m := range lengthUnits {
+		if !strings.HasSuffix(s, u) {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, u)), 64)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("%s: invalid length", s)
+		}
+		*f = lengthFlag(n * m)
+		return nil
+	}
+	return fmt.Errorf("%s: length needs unit pt, mm, cm, in or px", s)
+}
+
+// colorFlag validates and normalizes a color
+type colorFlag struct {
+	s *string
+}
+
+func (f colorFlag) String() string {
+	if f.s == nil {
+		return ""
+	}
+	return *f.s
+}
+
+func (f colorFlag) Set(s string) error {
+	c, err := color.Parse(s)
+	if err != nil {
+		return err
+	}
+	*f.s = c.Hex()
+	return nil
+}
+
+// ansiColorFlag sets ANSI color overrides, can be used multiple times
+type ansiColorFlag struct {
+	o *colorscheme.Overrides
+}
+
+func (f ansiColorFlag) String() string { return "" }
+
+func (f ansiColorFlag) Set(s string) error {
+	n, c, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("must be N=COLOR")
+	}
+	idx, err := colorscheme.ANSIColorIndex(n)
+	if err != nil {
+		return err
+	}
+	return colorFlag{s: &f.o.ANSI[idx]}.Set(c)
+}
+
+// loadColorScheme loads a embedded color scheme with exact name, a color scheme
+// file or embedded color scheme using fuzzy name matching
+func loadColorScheme(env Env, name string) (colorscheme.WorkbenchColorCustomizations, error) {
+	if n, err := schemes.Find(name); err == nil && n == name {
+		return schemes.Load(n)
+	}
+	b, err := env.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return schemes.Load(name)
+	} else if err != nil {
+		return colorscheme.WorkbenchColorCustomizations{}, err
+	}
+	return colorscheme.Parse(name, b)
+}
+
+// galleryColorSchemes resolves comma separated color scheme names, files or globs
+// matching embedded color scheme names, "all" is all embedded color schemes
+func galleryColorSchemes(env Env, patterns string, dark bool, light bool) ([]ansitosvg.NamedColorScheme, error) {
+	var ncss []ansitosvg.NamedColorScheme
+	seen := map[string]bool{}
+	add := func(name string, cs colorscheme.WorkbenchColorCustomizations) {
+		i := cs.Info(name)
+		if seen[name] || (dark && !i.Dark) || (light && i.Dark) {
+			return
+		}
+		seen[name] = true
+		ncss = append(ncss, ansitosvg.NamedColorScheme{Name: name, ColorScheme: cs})
+	}
+
+	for _, p := range strings.Split(patterns, ",") {
+		p = strings.TrimSpace(p)
+		if p == "all" {
+			p = "*"
+		}
+		if strings.ContainsAny(p, "*?[") {
+			lp := strings.ToLower(p)
+			for _, n := range schemes.Names() {
+				if ok, err := path.Match(lp, strings.ToLower(n)); err != nil {
+					return nil, err
+				} else if !ok {
+					continue
+				}
+				cs, err := schemes.Load(n)
+				if err != nil {
+					return nil, err
+				}
+				add(n, cs)
+			}
+			continue
+		}
+
+		cs, err := loadColorScheme(env, p)
+		if err != nil {
+			return nil, err
+		}
+		name := filepath.Base(p)
+		if n, err := schemes.Find(p); err == nil {
+			name = n
+		}
+		add(name, cs)
+	}
+	if len(ncss) == 0 {
+		return nil, fmt.Errorf("gallery: no color schemes matched %q", patterns)
+	}
+
+	return ncss, nil
+}
+
+// filterColorSchemes returns embedded color schemes, optionally only dark or light ones
+func filterColorSchemes(dark bool, light bool) ([]colorscheme.Info, error) {
+	infos, err := schemes.Infos()
+	if err != nil {
+		return nil, err
+	}
+	var is []colorscheme.Info
+	for _, i := range infos {
+		if (dark && !i.Dark) || (light && i.Dark) {
+			continue
+		}
+		is = append(is, i)
+	}
+	return is, nil
+}
+
 func Main(env Env) error {
 	fs := flag.NewFlagSet("ansisvg", flag.ContinueOnError)
 	var versionFlag bool
 	fs.BoolVar(&versionFlag, "v", false, "")
 	fs.BoolVar(&versionFlag, "version", false, "Show version")
 	var fontNameFlag = fs.String("fontname", ansitosvg.DefaultOptions.FontName, "NAME|Font name")
-	var fontFileFlag = fs.String("fontfile", "", "PATH|Font file to use and embed")
+	var fontFileFlag = fs.String("fontfile", "", "PATH|Font file to use and embed (TTF or OTF for png and pdf)")
+	var fullFontFlag = fs.Bool("fullfont", false, "Embed whole --fontfile instead of a subset with only used glyphs")
 	var fontRefFlag = fs.String("fontref", "", "URL|External font URL to use")
+	var fontRelativeFlag = fs.Bool("fontrelative", false, "Use font-relative units instead of pixel cell size from --fontfile metrics")
+	var fontFileBoldFlag = fs.String("fontfilebold", "", "PATH|Bold font file to use and embed")
+	var fontFileItalicFlag = fs.String("fontfileitalic", "", "PATH|Italic font file to use and embed")
+	var fontFileBoldItalicFlag = fs.String("fontfilebolditalic", "", "PATH|Bold italic font file to use and embed")
+	var fontRefBoldFlag = fs.String("fontrefbold", "", "URL|External bold font URL to use")
+	var fontRefItalicFlag = fs.String("fontrefitalic", "", "URL|External italic font URL to use")
+	var fontRefBoldItalicFlag = fs.String("fontrefbolditalic", "", "URL|External bold italic font URL to use")
+	var fakeBoldFlag = fs.Bool("fakebold", false, "Fake bold with a stroke instead of letting viewer synthesize it (if no bold font)")
 	var fontSizeFlag = fs.Int("fontsize", ansitosvg.DefaultOptions.FontSize, "NUMBER|Font size")
 	var lineHeightFlag = fs.Float64("lineheight", float64(ansitosvg.DefaultOptions.LineHeight), "NUMBER|Line height multiplier (default 1.0)")
 	var terminalWidthFlag int
 	fs.IntVar(&terminalWidthFlag, "w", 0, "")
 	fs.IntVar(&terminalWidthFlag, "width", 0, "NUMBER|Terminal width (auto if not set)")
 	var lineWrapFlag = fs.Bool("linewrap", false, "Wrap lines at terminal width (use with --width)")
-	var colorSchemeFlag = fs.String("colorscheme", ansitosvg.DefaultOptions.ColorScheme, "NAME|Color scheme")
+	var colorSchemeFlag = fs.String("colorscheme", ansitosvg.DefaultOptions.ColorScheme, "NAME|Color scheme name or file (iTerm2, Alacritty, kitty, Windows Terminal, Xresources, base16/base24 or VS Code)")
+	var darkColorSchemeFlag = fs.String("darkcolorscheme", "", "NAME|Color scheme used when viewer prefers dark mode (name or file)")
+	var cssVariablesFlag = fs.Bool("cssvariables", false, "Use CSS variables for scheme colors and dim opacity (--ansi-red, --term-bg, ...)")
+	var colorOverrides colorscheme.Overrides
+	fs.Var(colorFlag{s: &colorOverrides.Foreground}, "fg", "COLOR|Override foreground color (hex, rgb() or CSS color name)")
+	fs.Var(colorFlag{s: &colorOverrides.Background}, "bg", "COLOR|Override background color")
+	fs.Var(colorFlag{s: &colorOverrides.Cursor}, "cursor", "COLOR|Override cursor color")
+	fs.Var(colorFlag{s: &colorOverrides.Selection}, "selection", "COLOR|Override selection color")
+	fs.Var(ansiColorFlag{o: &colorOverrides}, "ansicolor", "N=COLOR|Override ANSI color 0-15 or name, ex red=#f00 (can be repeated)")
 	var listColorSchemesFlag = fs.Bool("listcolorschemes", false, "List color schemes")
+	var listJSONFlag = fs.Bool("listjson", false, "List color schemes as JSON with metadata (use with --listcolorschemes)")
+	var darkFlag = fs.Bool("dark", false, "Only dark color schemes (use with --listcolorschemes or --gallery)")
+	var lightFlag = fs.Bool("light", false, "Only light color schemes (use with --listcolorschemes or --gallery)")
+	var galleryFlag = fs.String("gallery", "", "SCHEMES|Render once per color scheme, comma separated names, files, globs or \"all\"")
+	var galleryColumnsFlag = fs.Int("gallerycolumns", ansitosvg.DefaultOptions.GalleryColumns, "NUMBER|Number of gallery columns")
+	var formatFlag = fs.String("format", ansitosvg.DefaultOptions.Format, "FORMAT|Output format, svg, html, png, pdf, json, text or ansi")
+	var fragmentFlag = fs.Bool("fragment", false, "HTML fragment with only <style> and <pre> (use with --format html)")
+	var inlineStylesFlag = fs.Bool("inlinestyles", false, "HTML with inline style attributes instead of classes (use with --format html)")
+	var scaleFlag = fs.Int("scale", ansitosvg.DefaultOptions.ImageScale, "NUMBER|Image scale, ex 2 for HiDPI (use with --format png)")
+	var pageWidth lengthFlag
+	fs.Var(&pageWidth, "pagewidth", "LENGTH|PDF page width, ex 210mm, 8.5in or 600pt (use with --format pdf)")
+	var textToPathFlag = fs.Bool("texttopath", false, "Render text as paths using glyph outlines from --fontfile (TTF or OTF with TrueType outlines)")
+	var colorModeFlag = fs.String("colormode", ansitosvg.DefaultOptions.ANSIColorMode, "MODE|Color mode for ansi format, truecolor, 256, 16 or palette")
 	var transparentFlag = fs.Bool("transparent", ansitosvg.DefaultOptions.Transparent, "Transparent background")
 	var gridModeFlag = fs.Bool("grid", false, "Grid mode (sets position for each character)")
+	var boxDrawingFlag = fs.Bool("boxdrawing", false, "Draw box drawing, block element and Powerline chars to fill the cell instead of using the font")
+	var pixelArtFlag = fs.Bool("pixelart", false, "Draw half block, quadrant, sextant and braille chars as merged pixel rects")
+	var clipCellsFlag = fs.Bool("clipcells", false, "Clip text runs to their cells (icons can overflow into a following space)")
+	var optimizeFlag = fs.Bool("optimize", false, "Optimize SVG for size (short class names, no whitespace, rounded coordinates)")
+	var svgzFlag = fs.Bool("svgz", false, "Gzip compress SVG output (.svgz)")
+	var streamFlag = fs.Bool("stream", false, "Render SVG line by line with memory use independent of input size")
+	var hybridGridFlag = fs.Bool("hybridgrid", false, "Hybrid grid mode (sets position for each styled run and length of non-ASCII runs)")
 	var fillOnlyFlag = fs.Bool("fillonly", ansitosvg.DefaultOptions.FillOnly, "Remove strokes from SVG output (use fills only)")
+	var titleFlag = fs.String("title", "", "TEXT|Image title (default window title with --accessible)")
+	var descriptionFlag = fs.String("description", "", "TEXT|Image description (default text content with --accessible)")
+	var accessibleFlag = fs.Bool("accessible", false, "Accessible image with title and description for screen readers")
+	var minContrastFlag = fs.Float64("mincontrast", ansitosvg.DefaultOptions.MinimumContrastRatio, "RATIO|Minimum foreground contrast ratio (1-21, WCAG, 4.5 is AA)")
 	var helpFlag bool
 	fs.BoolVar(&helpFlag, "h", false, "")
 	fs.BoolVar(&helpFlag, "help", false, "Show help")
@@ -107,47 +314,123 @@ Example usage:
 		return nil
 	}
 
+	if *scaleFlag < 1 {
+		return fmt.Errorf("scale must be at least 1")
+	}
+
+	if *darkFlag && *lightFlag {
+		return fmt.Errorf("dark and light can't be used together")
+	}
+
 	if *listColorSchemesFlag {
+		infos, err := filterColorSchemes(*darkFlag, *lightFlag)
+		if err != nil {
+			return err
+		}
+		if *listJSONFlag {
+			if infos == nil {
+				infos = []colorscheme.Info{}
+			}
+			e := json.NewEncoder(env.Stdout)
+			e.SetIndent("", "  ")
+			return e.Encode(infos)
+		}
+
 		maxNameLen := 0
-		for _, n := range schemes.Names() {
-			if len(n) > maxNameLen {
-				maxNameLen = len(n)
+		for _, i := range infos {
+			if len(i.Name) > maxNameLen {
+				maxNameLen = len(i.Name)
 			}
 		}
-		for _, n := range schemes.Names() {
-			s, _ := schemes.Load(n)
-			pad := strings.Repeat(" ", maxNameLen+1-len(n))
-			fmt.Fprintf(env.Stdout, "%s\n", s.ANSIDemo(n+pad))
+		for _, i := range infos {
+			pad := strings.Repeat(" ", maxNameLen+1-len(i.Name))
+			fmt.Fprintf(env.Stdout, "%s\n", i.Colors.ANSIDemo(i.Name+pad))
 		}
 		return nil
 	}
 
-	var fontEmbedded []byte
-	if *fontFileFlag != "" {
+	colorScheme, err := loadColorScheme(env, *colorSchemeFlag)
+	if err != nil {
+		return err
+	}
+
+	var darkColorScheme *colorscheme.WorkbenchColorCustomizations
+	if *darkColorSchemeFlag != "" {
+		cs, err := loadColorScheme(env, *darkColorSchemeFlag)
+		if err != nil {
+			return err
+		}
+		darkColorScheme = &cs
+	}
+
+	readFont := func(path string) ([]byte, error) {
+		if path == "" {
+			return nil, nil
+		}
+		return env.ReadFile(path)
+	}
+	var fonts [4][]byte
+	for i, path := range []string{*fontFileFlag, *fontFileBoldFlag, *fontFileItalicFlag, *fontFileBoldItalicFlag} {
 		var err error
-		fontEmbedded, err = env.ReadFile(*fontFileFlag)
+		if fonts[i], err = readFont(path); err != nil {
+			return err
+		}
+	}
+
+	opts := ansitosvg.Options{
+		FontName:               *fontNameFlag,
+		FontEmbedded:           fonts[0],
+		FontSubset:             !*fullFontFlag,
+		FontMetrics:            !*fontRelativeFlag,
+		FontRef:                *fontRefFlag,
+		FontBoldEmbedded:       fonts[1],
+		FontItalicEmbedded:     fonts[2],
+		FontBoldItalicEmbedded: fonts[3],
+		FontBoldRef:            *fontRefBoldFlag,
+		FontItalicRef:          *fontRefItalicFlag,
+		FontBoldItalicRef:      *fontRefBoldItalicFlag,
+		FontFakeBold:           *fakeBoldFlag,
+		FontSize:               *fontSizeFlag,
+		LineHeight:             float32(*lineHeightFlag),
+		TerminalWidth:          terminalWidthFlag,
+		LineWrap:               *lineWrapFlag,
+		CharBoxSize:            charBoxSize,
+		MarginSize:             marginSize,
+		CustomColorScheme:      &colorScheme,
+		CustomDarkColorScheme:  darkColorScheme,
+		ColorOverrides:         colorOverrides,
+		Transparent:            *transparentFlag,
+		GridMode:               *gridModeFlag,
+		HybridGridMode:         *hybridGridFlag,
+		BoxDrawing:             *boxDrawingFlag,
+		PixelArt:               *pixelArtFlag,
+		ClipCells:              *clipCellsFlag,
+		Optimize:               *optimizeFlag,
+		SVGZ:                   *svgzFlag,
+		Stream:                 *streamFlag,
+		FillOnly:               *fillOnlyFlag,
+		MinimumContrastRatio:   *minContrastFlag,
+		CSSVariables:           *cssVariablesFlag,
+		Format:                 *formatFlag,
+		HTMLFragment:           *fragmentFlag,
+		HTMLInlineStyles:       *inlineStylesFlag,
+		ImageScale:             *scaleFlag,
+		PDFPageWidth:           float64(pageWidth),
+		ANSIColorMode:          *colorModeFlag,
+		TextToPath:             *textToPathFlag,
+		GalleryColumns:         *galleryColumnsFlag,
+		Title:                  *titleFlag,
+		Description:            *descriptionFlag,
+		Accessible:             *accessibleFlag,
+	}
+
+	if *galleryFlag != "" {
+		ncss, err := galleryColorSchemes(env, *galleryFlag, *darkFlag, *lightFlag)
 		if err != nil {
 			return err
 		}
+		return ansitosvg.ConvertGallery(env.Stdin, env.Stdout, opts, ncss)
 	}
 
-	return ansitosvg.Convert(
-		env.Stdin,
-		env.Stdout,
-		ansitosvg.Options{
-			FontName:      *fontNameFlag,
-			FontEmbedded:  fontEmbedded,
-			FontRef:       *fontRefFlag,
-			FontSize:      *fontSizeFlag,
-			LineHeight:    float32(*lineHeightFlag),
-			TerminalWidth: terminalWidthFlag,
-			LineWrap:      *lineWrapFlag,
-			CharBoxSize:   charBoxSize,
-			MarginSize:    marginSize,
-			ColorScheme:   *colorSchemeFlag,
-			Transparent:   *transparentFlag,
-			GridMode:      *gridModeFlag,
-			FillOnly:      *fillOnlyFlag,
-		},
-	)
+	return ansitosvg.Convert(env.Stdin, env.Stdout, opts)
 }
@@ -2,15 +2,26 @@ package cli_test
 
 import (
 	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
 	"encoding/csv"
+	"encoding/xml"
 	"flag"
+	"fmt"
+	"image/png"
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/wader/ansisvg/cli"
 	"github.com/wader/ansisvg/internal/difftest"
+	"github.com/wader/ansisvg/sfnt"
 )
 
 var update = flag.Bool("update", false, "Update tests")
@@ -22,6 +33,27 @@ func argsSplit(s string) []string {
 	return rs
 }
 
+// runCLIWith runs ansisvg with args reading stdin and writing to stdout, files
+// are read from testdata
+func runCLIWith(stdin io.Reader, stdout io.Writer, stderr io.Writer, args ...string) error {
+	return cli.Main(cli.Env{
+		ReadFile: func(s string) ([]byte, error) { return os.ReadFile(filepath.Join("testdata", s)) },
+		Stdin:    stdin,
+		Stdout:   stdout,
+		Stderr:   stderr,
+		Args:     append([]string{"ansisvg"}, args...),
+	})
+}
+
+// runCLI runs ansisvg with args and stdin and returns stdout and stderr
+func runCLI(t testing.TB, stdin string, args ...string) ([]byte, []byte, error) {
+	t.Helper()
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	err := runCLIWith(strings.NewReader(stdin), stdout, stderr, args...)
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
 func testHelper(t *testing.T, pattern string, ext string) {
 	difftest.TestWithOptions(t, difftest.Options{
 		Path:        "testdata",
@@ -32,31 +64,14 @@ func testHelper(t *testing.T, pattern string, ext string) {
 			testDir := filepath.Dir(path)
 			testBase := filepath.Base(path)
 			testName := testBase[0 : len(testBase)-len(filepath.Ext(testBase))]
-			readFile := func(s string) ([]byte, error) {
-				return os.ReadFile(filepath.Join(testDir, s))
-			}
-			readFileOrEmpty := func(s string) []byte {
-				b, err := readFile(s)
-				if err != nil {
-					return nil
-				}
-				return b
-			}
-
-			args := string(readFileOrEmpty(testName + ".args"))
-			actualStdout := &bytes.Buffer{}
-			actualStderr := &bytes.Buffer{}
-			if err := cli.Main(cli.Env{
-				ReadFile: readFile,
-				Stdin:    strings.NewReader(input),
-				Stdout:   actualStdout,
-				Stderr:   actualStderr,
-				Args:     append([]string{"ansisvg"}, argsSplit(string(args))...),
-			}); err != nil {
+
+			args, _ := os.ReadFile(filepath.Join(testDir, testName+".args"))
+			stdout, _, err := runCLI(t, input, argsSplit(string(args))...)
+			if err != nil {
 				t.Error(err)
 			}
 
-			return filepath.Join(testDir, testName) + ext, actualStdout.String(), nil
+			return filepath.Join(testDir, testName) + ext, string(stdout), nil
 		},
 	})
 }
@@ -65,3 +80,469 @@ func TestMain(t *testing.T) {
 	testHelper(t, "*.ansi", ".svg")
 	testHelper(t, "*.stdin", ".stdout")
 }
+
+// sgrOSCRe matches SGR and OSC sequences, they don't move the cursor
+var sgrOSCRe = regexp.MustCompile(`\x1b\[[0-9;:]*m|\x1b\][^\x07\x1b]*(\x07|\x1b\\)`)
+
+// plainLines returns non-empty lines of input without SGR and OSC sequences
+// as a terminal would show them. Carriage returns overdraw and tabs move to
+// the next tab stop. False if there are other escape sequences.
+func plainLines(input string) ([]string, bool) {
+	input = sgrOSCRe.ReplaceAllString(input, "")
+	if strings.ContainsRune(input, '\x1b') {
+		return nil, false
+	}
+	var lines []string
+	for _, l := range strings.Split(input, "\n") {
+		var cells []rune
+		x := 0
+		for _, r := range l {
+			switch r {
+			case '\r':
+				x = 0
+			case '\t':
+				x += 8 - x%8
+			default:
+				for len(cells) <= x {
+					cells = append(cells, ' ')
+				}
+				cells[x] = r
+				x++
+			}
+		}
+		if s := strings.TrimRight(string(cells), " "); s != "" {
+			lines = append(lines, s)
+		}
+	}
+	return lines, true
+}
+
+// TestCopyPaste makes sure text selected and copied from the SVG, one <text> per
+// line, is the same as the original text, for example no extra or missing whitespace
+func TestCopyPaste(t *testing.T) {
+	paths, err := filepath.Glob("testdata/*.ansi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		path := path
+		t.Run(path, func(t *testing.T) {
+			argsBytes, _ := os.ReadFile(strings.TrimSuffix(path, ".ansi") + ".args")
+			args := argsSplit(string(argsBytes))
+			for _, a := range args {
+				switch a {
+				case "--gallery":
+					t.Skip("no single text content")
+				case "--texttopath":
+					t.Skip("no text")
+				case "--pixelart":
+					t.Skip("pixel chars are not text")
+				case "--clipcells":
+					t.Skip("text runs are separate text elements")
+				case "--linewrap":
+					t.Skip("lines are wrapped")
+				}
+			}
+			input, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected, ok := plainLines(string(input))
+			if !ok {
+				t.Skip("input moves cursor")
+			}
+			stdout, _, err := runCLI(t, string(input), args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var svg struct {
+				Texts []struct {
+					Spans []struct {
+						Content string `xml:",chardata"`
+					} `xml:"tspan"`
+				} `xml:"text"`
+			}
+			if err := xml.Unmarshal(stdout, &svg); err != nil {
+				t.Fatal(err)
+			}
+
+			var actual []string
+			for _, te := range svg.Texts {
+				var sb strings.Builder
+				for _, ts := range te.Spans {
+					sb.WriteString(ts.Content)
+				}
+				if s := strings.TrimRight(sb.String(), " "); s != "" {
+					actual = append(actual, s)
+				}
+			}
+			if strings.Join(expected, "\n") != strings.Join(actual, "\n") {
+				t.Errorf("expected:\n%s\nactual:\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	paths, err := filepath.Glob("testdata/*.ansi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		path := path
+		t.Run(path, func(t *testing.T) {
+			argsBytes, _ := os.ReadFile(strings.TrimSuffix(path, ".ansi") + ".args")
+			args := argsSplit(string(argsBytes))
+			for _, a := range args {
+				switch a {
+				case "--gallery", "--texttopath", "--boxdrawing", "--pixelart", "--clipcells", "--accessible":
+					t.Skip("not supported when streaming")
+				case "--format", "-f":
+					t.Skip("only SVG output")
+				}
+			}
+			input, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			run := func(args ...string) []byte {
+				stdout, _, err := runCLI(t, string(input), args...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return stdout
+			}
+			expected := run(args...)
+			actual := run(append([]string{"--stream"}, args...)...)
+			if !bytes.Equal(expected, actual) {
+				t.Errorf("expected:\n%s\nactual:\n%s", expected, actual)
+			}
+			if actual := gunzip(t, run(append([]string{"--stream", "--svgz"}, args...)...)); !bytes.Equal(expected, actual) {
+				t.Errorf("svgz expected:\n%s\nactual:\n%s", expected, actual)
+			}
+		})
+	}
+}
+
+func TestPNG(t *testing.T) {
+	// "A" on default background, space on red background
+	const input = "A\x1b[41m \x1b[0m\n"
+	for _, tc := range []struct {
+		args  []string
+		cellW int
+		cellH int
+	}{
+		{args: nil, cellW: 7, cellH: 13},
+		{args: []string{"--scale", "2"}, cellW: 14, cellH: 26},
+		{args: []string{"--charboxsize", "10x20"}, cellW: 10, cellH: 20},
+		{args: []string{"--fontfile", "Go-Mono.ttf", "--fontsize", "20"}, cellW: 12, cellH: 23},
+	} {
+		tc := tc
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			stdout, _, err := runCLI(t, input, append([]string{"--format", "png"}, tc.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			img, err := png.Decode(bytes.NewReader(stdout))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if w, h := img.Bounds().Dx(), img.Bounds().Dy(); w != tc.cellW*2 || h != tc.cellH {
+				t.Fatalf("expected size %dx%d, got %dx%d", tc.cellW*2, tc.cellH, w, h)
+			}
+			hex := func(x, y int) string {
+				r, g, b, _ := img.At(x, y).RGBA()
+				return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+			}
+			// builtin dark scheme
+			if c := hex(tc.cellW+tc.cellW/2, tc.cellH/2); c != "#bb0000" {
+				t.Errorf("expected red background, got %s", c)
+			}
+			if c := hex(0, 0); c != "#000000" {
+				t.Errorf("expected black background, got %s", c)
+			}
+			fgPixels := 0
+			for y := 0; y < tc.cellH; y++ {
+				for x := 0; x < tc.cellW; x++ {
+					if hex(x, y) == "#bbbbbb" {
+						fgPixels++
+					}
+				}
+			}
+			if fgPixels == 0 {
+				t.Errorf("expected glyph pixels")
+			}
+		})
+	}
+}
+
+func TestPDF(t *testing.T) {
+	const input = "Hello \x1b[1;41mworld\x1b[0m\n"
+	streamRe := regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`)
+	mediaBoxRe := regexp.MustCompile(`/MediaBox \[0 0 ([0-9.]+) ([0-9.]+)\]`)
+	for _, tc := range []struct {
+		args      []string
+		width     string
+		fontType  string
+		textShown string
+	}{
+		{args: nil, width: "69.3", fontType: "/Type1", textShown: "(Hello) Tj"},
+		{args: []string{"--pagewidth", "100mm"}, width: "283.465", fontType: "/Type1", textShown: "(Hello) Tj"},
+		{args: []string{"--fontfile", "Go-Mono.ttf"}, fontType: "/CIDFontType2", textShown: "> Tj"},
+	} {
+		tc := tc
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			b, _, err := runCLI(t, input, append([]string{"--format", "pdf"}, tc.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.HasPrefix(b, []byte("%PDF-")) || !bytes.HasSuffix(b, []byte("%%EOF\n")) {
+				t.Fatalf("expected PDF header and trailer")
+			}
+			if !bytes.Contains(b, []byte(tc.fontType)) {
+				t.Errorf("expected %s font", tc.fontType)
+			}
+			if tc.width != "" {
+				sm := mediaBoxRe.FindSubmatch(b)
+				if sm == nil || string(sm[1]) != tc.width {
+					t.Errorf("expected page width %s, got %q", tc.width, sm)
+				}
+			}
+
+			// xref offsets should point to objects
+			xrefIdx := bytes.LastIndex(b, []byte("\nxref\n"))
+			if xrefIdx == -1 {
+				t.Fatal("expected xref")
+			}
+			xrefLines := strings.Split(string(b[xrefIdx+1:]), "\n")
+			for i, l := range xrefLines[3:] {
+				if !strings.HasSuffix(l, " n ") {
+					break
+				}
+				off, _ := strconv.Atoi(l[0:10])
+				if prefix := fmt.Sprintf("%d 0 obj", i+1); !bytes.HasPrefix(b[off:], []byte(prefix)) {
+					t.Errorf("expected %q at offset %d", prefix, off)
+				}
+			}
+
+			var content []string
+			for _, sm := range streamRe.FindAllSubmatch(b, -1) {
+				zr, err := zlib.NewReader(bytes.NewReader(sm[1]))
+				if err != nil {
+					continue
+				}
+				d, err := io.ReadAll(zr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				content = append(content, string(d))
+			}
+			if !strings.Contains(strings.Join(content, "\n"), tc.textShown) {
+				t.Errorf("expected %q in content streams", tc.textShown)
+			}
+		})
+	}
+}
+
+func TestFontSubset(t *testing.T) {
+	const input = "Hello ┌─┐ åäö\n"
+	fontRe := regexp.MustCompile(`src: url\(data:font/ttf;base64,([^)]*)\) format\("truetype"\)`)
+	full, err := os.ReadFile("testdata/Go-Mono.ttf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		args      []string
+		numGlyphs int
+	}{
+		// .notdef, space and used glyphs
+		{args: nil, numGlyphs: 12},
+		{args: []string{"--fullfont"}, numGlyphs: 712},
+	} {
+		tc := tc
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			stdout, _, err := runCLI(t, input, append([]string{"--fontfile", "Go-Mono.ttf"}, tc.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sm := fontRe.FindSubmatch(stdout)
+			if sm == nil {
+				t.Fatal("expected embedded TrueType font")
+			}
+			b, err := base64.RawStdEncoding.DecodeString(string(sm[1]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tc.args) != 0 && !bytes.Equal(b, full) {
+				t.Error("expected whole font")
+			}
+			f, err := sfnt.Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f.NumGlyphs != tc.numGlyphs {
+				t.Errorf("expected %d glyphs, got %d", tc.numGlyphs, f.NumGlyphs)
+			}
+			for _, r := range strings.TrimSpace(input) {
+				if f.GlyphIndex(r) == 0 {
+					t.Errorf("%q: expected glyph", r)
+				}
+			}
+		})
+	}
+}
+
+func gunzip(t *testing.T, b []byte) []byte {
+	t.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err = io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestSVGZ(t *testing.T) {
+	const input = "Hello \x1b[1;41mworld\x1b[0m\n"
+	run := func(args ...string) []byte {
+		stdout, _, err := runCLI(t, input, append([]string{"--optimize"}, args...)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stdout
+	}
+	if b, expected := gunzip(t, run("--svgz")), run(); !bytes.Equal(b, expected) {
+		t.Errorf("expected:\n%s\nactual:\n%s", expected, b)
+	}
+}
+
+func TestOptionErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		err  string
+	}{
+		{[]string{"--mincontrast", "0.5"}, "mincontrast must be between 1 and 21"},
+		{[]string{"--mincontrast", "500", "--format", "png"}, "mincontrast must be between 1 and 21"},
+		{[]string{"--mincontrast", "-1", "--gallery", "Builtin Dark"}, "mincontrast must be between 1 and 21"},
+		{[]string{"--mincontrast", "7", "--cssvariables"}, "mincontrast can't be used with CSS variables or a dark color scheme"},
+		{[]string{"--mincontrast", "7", "--darkcolorscheme", "Builtin Light"}, "mincontrast can't be used with CSS variables or a dark color scheme"},
+		{[]string{"--mincontrast", "7", "--cssvariables", "--gallery", "Builtin Dark"}, "mincontrast can't be used with CSS variables or a dark color scheme"},
+		{[]string{"--fontsize", "0"}, "fontsize must be greater than 0"},
+		{[]string{"--fontsize", "-5", "--fontfile", "Go-Mono.ttf", "--format", "png"}, "fontsize must be greater than 0"},
+		{[]string{"--lineheight", "0", "--format", "pdf"}, "lineheight must be greater than 0"},
+		{[]string{"--lineheight", "-1", "--gallery", "Builtin Dark"}, "lineheight must be greater than 0"},
+		{[]string{"--scale", "0"}, "scale must be at least 1"},
+		{[]string{"--scale", "-3", "--format", "png"}, "scale must be at least 1"},
+		{[]string{"--scale", "100000", "--format", "png"}, "image size 2800000x1300000 is larger than max 268435456 pixels"},
+		{[]string{"--scale", "1000", "--format", "png", "--fontfile", "Go-Mono.ttf"}, "image size 32000x16000 is larger than max 268435456 pixels"},
+		{[]string{"--fakebold", "--fillonly"}, "fakebold can't be used with fillonly"},
+		{[]string{"--svgz", "--format", "html"}, "svgz: html output not supported"},
+		{[]string{"--optimize", "--format", "html"}, "optimize: html output not supported"},
+		{[]string{"--svgz", "--format", "html", "--gallery", "Builtin Dark"}, "svgz: html output not supported"},
+		{[]string{"--optimize", "--gallery", "Builtin Dark"}, "optimize: gallery not supported"},
+		{[]string{"--texttopath", "--fontfile", "Go-Mono.ttf", "--gallery", "Builtin Dark"}, "text to path: gallery not supported"},
+		{[]string{"--darkcolorscheme", "Builtin Light", "--gallery", "Builtin Dark"}, "dark color scheme: gallery not supported"},
+		{[]string{"--title", "Title", "--gallery", "Builtin Dark"}, "title, description and accessible: gallery not supported"},
+		{[]string{"--accessible", "--gallery", "Builtin Dark"}, "title, description and accessible: gallery not supported"},
+		{[]string{"--format", "html", "--fragment", "--gallery", "Builtin Dark"}, "fragment and inline styles: gallery not supported"},
+		{[]string{"--gallerycolumns", "0", "--gallery", "Builtin Dark"}, "gallery columns must be at least 1"},
+		{[]string{"--gallerycolumns", "-2", "--gallery", "Builtin Dark"}, "gallery columns must be at least 1"},
+	} {
+		tc := tc
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			_, _, err := runCLI(t, "\x1b[34mblue\x1b[0m\n", tc.args...)
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+// ciLogReader generates lines of colored CI log like output
+type ciLogReader struct {
+	lines int
+	n     int
+	buf   []byte
+}
+
+func (r *ciLogReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.n == r.lines {
+			return 0, io.EOF
+		}
+		r.buf = append(r.buf[:0], fmt.Sprintf(
+			"\x1b[2m%6d\x1b[0m \x1b[32mok\x1b[0m  github.com/example/pkg%d \x1b[1m%d.%03ds\x1b[0m \x1b[38;2;%d;100;200mcached\x1b[0m \x1b[41;97m %d \x1b[0m\n",
+			r.n, r.n%100, r.n%10, r.n%1000, r.n%256, r.n)...)
+		r.n++
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// heapSampler samples heap in use while reading input and writing output
+type heapSampler struct {
+	r    io.Reader
+	w    io.Writer
+	n    int
+	next int
+	peak uint64
+}
+
+func (hs *heapSampler) sample(n int) {
+	hs.n += n
+	if hs.n < hs.next {
+		return
+	}
+	hs.next = hs.n + 256*1024
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	if ms.HeapInuse > hs.peak {
+		hs.peak = ms.HeapInuse
+	}
+}
+
+func (hs *heapSampler) Read(p []byte) (int, error) {
+	n, err := hs.r.Read(p)
+	hs.sample(n)
+	return n, err
+}
+
+func (hs *heapSampler) Write(p []byte) (int, error) {
+	n, err := hs.w.Write(p)
+	hs.sample(n)
+	return n, err
+}
+
+func benchmarkLines(b *testing.B, args ...string) {
+	for _, lines := range []int{1000, 10000, 100000} {
+		lines := lines
+		b.Run(strconv.Itoa(lines), func(b *testing.B) {
+			b.ReportAllocs()
+			var peak uint64
+			for i := 0; i < b.N; i++ {
+				runtime.GC()
+				hs := &heapSampler{r: &ciLogReader{lines: lines}, w: io.Discard}
+				if err := runCLIWith(hs, hs, io.Discard, args...); err != nil {
+					b.Fatal(err)
+				}
+				if hs.peak > peak {
+					peak = hs.peak
+				}
+			}
+			b.ReportMetric(float64(peak)/(1<<20), "peak-heap-MB")
+		})
+	}
+}
+
+func BenchmarkRender(b *testing.B) { benchmarkLines(b) }
+
+func BenchmarkRenderStream(b *testing.B) { benchmarkLines(b, "--stream") }
+
+func BenchmarkRenderGrid(b *testing.B) { benchmarkLines(b, "--grid") }
+
+func BenchmarkRenderText(b *testing.B) { benchmarkLines(b, "--format", "text") }
@@ -2,8 +2,10 @@ package color
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 type Color struct {
@@ -25,6 +27,50 @@ func NewFromHex(s string) Color {
 	}
 }
 
+var hexRe = regexp.MustCompile(`^(?:#|0x|0X)([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+var x11RGBRe = regexp.MustCompile(`^rgb:([0-9a-fA-F]{1,4})/([0-9a-fA-F]{1,4})/([0-9a-fA-F]{1,4})$`)
+var cssRGBRe = regexp.MustCompile(`^rgb\(\s*([0-9.]+%?)\s*[,\s]\s*([0-9.]+%?)\s*[,\s]\s*([0-9.]+%?)\s*\)$`)
+
+// Parse parses a color in the form #rgb, #rrggbb, 0xrrggbb, CSS rgb(r, g, b),
+// CSS color name or X11 rgb:r/g/b
+func Parse(s string) (Color, error) {
+	s = strings.TrimSpace(s)
+	if h, ok := cssNames[strings.ToLower(s)]; ok {
+		return NewFromHex(h), nil
+	}
+	if parts := cssRGBRe.FindStringSubmatch(strings.ToLower(s)); parts != nil {
+		var vs [3]float32
+		for i, p := range parts[1:] {
+			max := 255.0
+			if strings.HasSuffix(p, "%") {
+				p = p[:len(p)-1]
+				max = 100
+			}
+			f, err := strconv.ParseFloat(p, 64)
+			if err != nil || f > max {
+				return Color{}, fmt.Errorf("%q: invalid rgb() component %q", s, parts[i+1])
+			}
+			vs[i] = float32(f / max)
+		}
+		return Color{R: vs[0], G: vs[1], B: vs[2]}, nil
+	}
+	if parts := hexRe.FindStringSubmatch(s); parts != nil {
+		h := parts[1]
+		if len(h) == 3 {
+			h = string([]byte{h[0], h[0], h[1], h[1], h[2], h[2]})
+		}
+		return NewFromHex("#" + h), nil
+	}
+	if parts := x11RGBRe.FindStringSubmatch(s); parts != nil {
+		f := func(s string) float32 {
+			n, _ := strconv.ParseUint(s, 16, 16)
+			return float32(n) / float32(uint64(1)<<(4*len(s))-1)
+		}
+		return Color{R: f(parts[1]), G: f(parts[2]), B: f(parts[3])}, nil
+	}
+	return Color{}, fmt.Errorf("%q: invalid color", s)
+}
+
 func (c Color) ANSITriple() string {
 	return fmt.Sprintf("%d:%d:%d",
 		int(c.R*255),
@@ -40,3 +86,160 @@ func (c Color) ANSIBG() string {
 func (c Color) ANSIFG() string {
 	return fmt.Sprintf("\x1b[38:2:%sm", c.ANSITriple())
 }
+
+func (c Color) Hex() string {
+	f := func(v float32) int {
+		n := int(math.Round(float64(v) * 255))
+		if n < 0 {
+			return 0
+		} else if n > 255 {
+			return 255
+		}
+		return n
+	}
+	return fmt.Sprintf("#%.2x%.2x%.2x", f(c.R), f(c.G), f(c.B))
+}
+
+func srgbToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// RelativeLuminance as defined by WCAG 2
+// https://www.w3.org/TR/WCAG21/#dfn-relative-luminance
+func (c Color) RelativeLuminance() float64 {
+	return 0.2126*srgbToLinear(float64(c.R)) +
+		0.7152*srgbToLinear(float64(c.G)) +
+		0.0722*srgbToLinear(float64(c.B))
+}
+
+// ContrastRatio returns WCAG 2 contrast ratio between a and b, 1 to 21
+// https://www.w3.org/TR/WCAG21/#dfn-contrast-ratio
+func ContrastRatio(a, b Color) float64 {
+	la := a.RelativeLuminance()
+	lb := b.RelativeLuminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// oklab is a color in the OKLab perceptual color space
+// https://bottosson.github.io/posts/oklab/
+type oklab struct {
+	L, A, B float64
+}
+
+func (c Color) oklab() oklab {
+	r := srgbToLinear(float64(c.R))
+	g := srgbToLinear(float64(c.G))
+	b := srgbToLinear(float64(c.B))
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	return oklab{
+		L: 0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		A: 1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		B: 0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+func (o oklab) color() Color {
+	l := o.L + 0.3963377774*o.A + 0.2158037573*o.B
+	m := o.L - 0.1055613458*o.A - 0.0638541728*o.B
+	s := o.L - 0.0894841775*o.A - 1.2914855480*o.B
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	f := func(v float64) float32 {
+		return float32(math.Max(0, math.Min(1, linearToSRGB(v))))
+	}
+	return Color{
+		R: f(+4.0767416621*l - 3.3077115913*m + 0.2309699292*s),
+		G: f(-1.2684380046*l + 2.6097574011*m - 0.3413193965*s),
+		B: f(-0.0041960863*l - 0.7034186147*m + 1.7076127010*s),
+	}
+}
+
+func (o oklab) lerp(t oklab, f float64) oklab {
+	return oklab{
+		L: o.L + (t.L-o.L)*f,
+		A: o.A + (t.A-o.A)*f,
+		B: o.B + (t.B-o.B)*f,
+	}
+}
+
+// EnsureContrast returns c adjusted to have at least contrast ratio against bg.
+// The color is moved in OKLab space towards white or black, whichever
+// reaches the ratio with the smallest change. If no adjustment can reach the
+// ratio the color with the highest possible contrast is returned.
+func (c Color) EnsureContrast(bg Color, ratio float64) Color {
+	if ContrastRatio(c, bg) >= ratio {
+		return c
+	}
+
+	from := c.oklab()
+	search := func(to oklab) (Color, float64, bool) {
+		end := from.lerp(to, 1).color()
+		if ContrastRatio(end, bg) < ratio {
+			return end, 1, false
+		}
+		lo, hi := 0.0, 1.0
+		for i := 0; i < 20; i++ {
+			mid := (lo + hi) / 2
+			if ContrastRatio(from.lerp(to, mid).color(), bg) >= ratio {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+		return from.lerp(to, hi).color(), hi, true
+	}
+
+	lighter, lf, lok := search(oklab{L: 1})
+	darker, df, dok := search(oklab{L: 0})
+	switch {
+	case lok && dok:
+		if lf <= df {
+			return lighter
+		}
+		return darker
+	case lok:
+		return lighter
+	case dok:
+		return darker
+	}
+	if ContrastRatio(lighter, bg) >= ContrastRatio(darker, bg) {
+		return lighter
+	}
+	return darker
+}
+
+// Distance returns perceptual distance between two colors, euclidean distance in OKLab space
+func (c Color) Distance(o Color) float64 {
+	a, b := c.oklab(), o.oklab()
+	return math.Sqrt((a.L-b.L)*(a.L-b.L) + (a.A-b.A)*(a.A-b.A) + (a.B-b.B)*(a.B-b.B))
+}
+
+// Nearest returns index of color in cs nearest to c
+func (c Color) Nearest(cs []Color) int {
+	best := -1
+	bestDistance := 0.0
+	for i, o := range cs {
+		if d := c.Distance(o); best == -1 || d < bestDistance {
+			best = i
+			bestDistance = d
+		}
+	}
+	return best
+}
@@ -35,6 +35,41 @@ type VSCodeColorScheme struct {
 	WorkbenchColorCustomizations WorkbenchColorCustomizations `json:"workbench.colorCustomizations"`
 }
 
+// ANSIColors returns the 16 ANSI colors in SGR order, black, red, ... bright white
+func (w WorkbenchColorCustomizations) ANSIColors() [16]string {
+	var cs [16]string
+	for i, p := range w.ansiColorPtrs() {
+		cs[i] = *p
+	}
+	return cs
+}
+
+// SetANSIColor sets ANSI color n (0-15) in SGR order
+func (w *WorkbenchColorCustomizations) SetANSIColor(n int, c string) {
+	*w.ansiColorPtrs()[n] = c
+}
+
+func (w *WorkbenchColorCustomizations) ansiColorPtrs() [16]*string {
+	return [16]*string{
+		&w.ANSIBlack,
+		&w.ANSIRed,
+		&w.ANSIGreen,
+		&w.ANSIYellow,
+		&w.ANSIBlue,
+		&w.ANSIMagenta,
+		&w.ANSICyan,
+		&w.ANSIWhite,
+		&w.ANSIBrightBlack,
+		&w.ANSIBrightRed,
+		&w.ANSIBrightGreen,
+		&w.ANSIBrightYellow,
+		&w.ANSIBrightBlue,
+		&w.ANSIBrightMagenta,
+		&w.ANSIBrightCyan,
+		&w.ANSIBrightWhite,
+	}
+}
+
 func (w WorkbenchColorCustomizations) ANSIDemo(s string) string {
 	b := color.NewFromHex(w.Background)
 	f := color.NewFromHex(w.Foreground)
@@ -0,0 +1,296 @@
+package colorscheme
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wader/ansisvg/color"
+)
+
+var ansiNames = [8]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+func parseVSCode(b []byte) (WorkbenchColorCustomizations, error) {
+	var vsCS VSCodeColorScheme
+	if err := json.Unmarshal(b, &vsCS); err != nil {
+		return vsCS.WorkbenchColorCustomizations, err
+	}
+	return vsCS.WorkbenchColorCustomizations, nil
+}
+
+// iTerm2 .itermcolors is a plist with a dict of color name to component dicts
+// <key>Ansi 0 Color</key><dict><key>Blue Component</key><real>0.0</real>...</dict>
+func parseITerm2(b []byte) (WorkbenchColorCustomizations, error) {
+	var w WorkbenchColorCustomizations
+
+	type plistValue struct {
+		XMLName xml.Name
+		Value   string `xml:",chardata"`
+	}
+	type plistDict struct {
+		Entries []struct {
+			XMLName xml.Name
+			Value   string       `xml:",chardata"`
+			Items   []plistValue `xml:",any"`
+		} `xml:",any"`
+	}
+	var plist struct {
+		Dict plistDict `xml:"dict"`
+	}
+	if err := xml.Unmarshal(b, &plist); err != nil {
+		return w, err
+	}
+
+	colors := map[string]string{}
+	var key string
+	for _, e := range plist.Dict.Entries {
+		switch e.XMLName.Local {
+		case "key":
+			key = e.Value
+		case "dict":
+			var c color.Color
+			var component string
+			for _, i := range e.Items {
+				switch i.XMLName.Local {
+				case "key":
+					component = i.Value
+				case "real", "integer":
+					f, err := strconv.ParseFloat(strings.TrimSpace(i.Value), 32)
+					if err != nil {
+						return w, fmt.Errorf("%s: %s: %w", key, component, err)
+					}
+					switch component {
+					case "Red Component":
+						c.R = float32(f)
+					case "Green Component":
+						c.G = float32(f)
+					case "Blue Component":
+						c.B = float32(f)
+					}
+				}
+			}
+			colors[key] = c.Hex()
+		}
+	}
+
+	w.Foreground = colors["Foreground Color"]
+	w.Background = colors["Background Color"]
+	w.CursorForeground = colors["Cursor Color"]
+	w.SelectionBackground = colors["Selection Color"]
+	for i := 0; i < 16; i++ {
+		w.SetANSIColor(i, colors[fmt.Sprintf("Ansi %d Color", i)])
+	}
+
+	return w, nil
+}
+
+// alacrittyFromKV maps flattened alacritty TOML or YAML config, colors.primary.foreground etc
+func alacrittyFromKV(kv map[string]string) WorkbenchColorCustomizations {
+	var w WorkbenchColorCustomizations
+	w.Foreground = kv["colors.primary.foreground"]
+	w.Background = kv["colors.primary.background"]
+	w.CursorForeground = kv["colors.cursor.cursor"]
+	w.SelectionBackground = kv["colors.selection.background"]
+	for i, n := range ansiNames {
+		w.SetANSIColor(i, kv["colors.normal."+n])
+		w.SetANSIColor(i+8, kv["colors.bright."+n])
+	}
+	return w
+}
+
+func parseKitty(b []byte) WorkbenchColorCustomizations {
+	var w WorkbenchColorCustomizations
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		k, v := fields[0], fields[1]
+		switch k {
+		case "foreground":
+			w.Foreground = v
+		case "background":
+			w.Background = v
+		case "cursor":
+			w.CursorForeground = v
+		case "selection_background":
+			w.SelectionBackground = v
+		default:
+			if n, ok := colorN(k, "color"); ok {
+				w.SetANSIColor(n, v)
+			}
+		}
+	}
+	return w
+}
+
+// colorN parses prefix followed by 0-15
+func colorN(s string, prefix string) (int, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s[len(prefix):])
+	if err != nil || n < 0 || n > 15 {
+		return 0, false
+	}
+	return n, true
+}
+
+type windowsTerminalScheme struct {
+	Foreground          string `json:"foreground"`
+	Background          string `json:"background"`
+	CursorColor         string `json:"cursorColor"`
+	SelectionBackground string `json:"selectionBackground"`
+	Black               string `json:"black"`
+	Red                 string `json:"red"`
+	Green               string `json:"green"`
+	Yellow              string `json:"yellow"`
+	Blue                string `json:"blue"`
+	Purple              string `json:"purple"`
+	Cyan                string `json:"cyan"`
+	White               string `json:"white"`
+	BrightBlack         string `json:"brightBlack"`
+	BrightRed           string `json:"brightRed"`
+	BrightGreen         string `json:"brightGreen"`
+	BrightYellow        string `json:"brightYellow"`
+	BrightBlue          string `json:"brightBlue"`
+	BrightPurple        string `json:"brightPurple"`
+	BrightCyan          string `json:"brightCyan"`
+	BrightWhite         string `json:"brightWhite"`
+}
+
+// parseWindowsTerminal parses a scheme object or a settings.json and uses the first scheme
+func parseWindowsTerminal(b []byte) (WorkbenchColorCustomizations, error) {
+	var w WorkbenchColorCustomizations
+	var settings struct {
+		Schemes []windowsTerminalScheme `json:"schemes"`
+	}
+	if err := json.Unmarshal(b, &settings); err != nil {
+		return w, err
+	}
+	var s windowsTerminalScheme
+	if len(settings.Schemes) > 0 {
+		s = settings.Schemes[0]
+	} else if err := json.Unmarshal(b, &s); err != nil {
+		return w, err
+	}
+
+	return WorkbenchColorCustomizations{
+		Foreground:          s.Foreground,
+		Background:          s.Background,
+		ANSIBlack:           s.Black,
+		ANSIBlue:            s.Blue,
+		ANSICyan:            s.Cyan,
+		ANSIGreen:           s.Green,
+		ANSIMagenta:         s.Purple,
+		ANSIRed:             s.Red,
+		ANSIWhite:           s.White,
+		ANSIYellow:          s.Yellow,
+		ANSIBrightBlack:     s.BrightBlack,
+		ANSIBrightBlue:      s.BrightBlue,
+		ANSIBrightCyan:      s.BrightCyan,
+		ANSIBrightGreen:     s.BrightGreen,
+		ANSIBrightMagenta:   s.BrightPurple,
+		ANSIBrightRed:       s.BrightRed,
+		ANSIBrightWhite:     s.BrightWhite,
+		ANSIBrightYellow:    s.BrightYellow,
+		SelectionBackground: s.SelectionBackground,
+		CursorForeground:    s.CursorColor,
+	}, nil
+}
+
+var xresourcesDefineRe = regexp.MustCompile(`^#define\s+(\S+)\s+(\S+)`)
+
+// parseXresources parses lines like "*.color0: #000000" or "URxvt*foreground: #fff",
+// #define macros are substituted
+func parseXresources(b []byte) WorkbenchColorCustomizations {
+	var w WorkbenchColorCustomizations
+	defines := map[string]string{}
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if parts := xresourcesDefineRe.FindStringSubmatch(l); parts != nil {
+			defines[parts[1]] = parts[2]
+			continue
+		}
+		if l == "" || l[0] == '!' || l[0] == '#' {
+			continue
+		}
+		k, v, ok := strings.Cut(l, ":")
+		if !ok {
+			continue
+		}
+		if i := strings.LastIndexAny(k, ".*"); i != -1 {
+			k = k[i+1:]
+		}
+		k = strings.TrimSpace(k)
+		v = strings.TrimSpace(v)
+		if d, ok := defines[v]; ok {
+			v = d
+		}
+
+		switch k {
+		case "foreground":
+			w.Foreground = v
+		case "background":
+			w.Background = v
+		case "cursorColor":
+			w.CursorForeground = v
+		case "highlightColor":
+			w.SelectionBackground = v
+		default:
+			if n, ok := colorN(k, "color"); ok {
+				w.SetANSIColor(n, v)
+			}
+		}
+	}
+	return w
+}
+
+// base16 and base24 terminal mapping, see https://github.com/tinted-theming/home
+var base16ANSI = [16]string{
+	"base00", "base08", "base0B", "base0A", "base0D", "base0E", "base0C", "base05",
+	"base03", "base08", "base0B", "base0A", "base0D", "base0E", "base0C", "base07",
+}
+var base24ANSI = [16]string{
+	"base00", "base08", "base0B", "base0A", "base0D", "base0E", "base0C", "base05",
+	"base02", "base12", "base14", "base13", "base16", "base17", "base15", "base07",
+}
+
+// base16FromKV maps base16 or base24 scheme, either old style with top level
+// baseNN keys or new style with a palette map. Values can be with or without #.
+func base16FromKV(kv map[string]string) WorkbenchColorCustomizations {
+	base := map[string]string{}
+	for k, v := range kv {
+		k = strings.TrimPrefix(k, "palette.")
+		if len(k) != 6 || !strings.HasPrefix(k, "base") {
+			continue
+		}
+		if !strings.HasPrefix(v, "#") {
+			v = "#" + v
+		}
+		base[strings.ToUpper(k[4:])] = v
+	}
+	get := func(k string) string { return base[strings.ToUpper(k[4:])] }
+
+	mapping := base16ANSI
+	if _, ok := base["10"]; ok {
+		mapping = base24ANSI
+	}
+
+	var w WorkbenchColorCustomizations
+	w.Foreground = get("base05")
+	w.Background = get("base00")
+	w.CursorForeground = get("base05")
+	w.SelectionBackground = get("base02")
+	for i, k := range mapping {
+		w.SetANSIColor(i, get(k))
+	}
+	return w
+}
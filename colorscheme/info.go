@@ -0,0 +1,43 @@
+package colorscheme
+
+import (
+	"math"
+
+	"github.com/wader/ansisvg/color"
+)
+
+// Info is metadata computed from a color scheme
+type Info struct {
+	Name string `json:"name"`
+	// Dark is true if background has better contrast against white than black
+	Dark bool `json:"dark"`
+	// BackgroundLuminance is WCAG relative luminance of background, 0-1
+	BackgroundLuminance float64 `json:"backgroundLuminance"`
+	// MinContrast is the lowest WCAG contrast ratio against background of
+	// foreground and the non-black/white ANSI colors (red to cyan, normal and bright)
+	MinContrast float64                      `json:"minContrast"`
+	Colors      WorkbenchColorCustomizations `json:"colors"`
+}
+
+func (w WorkbenchColorCustomizations) Info(name string) Info {
+	bg := color.NewFromHex(w.Background)
+	l := bg.RelativeLuminance()
+	black := color.Color{}
+	white := color.Color{R: 1, G: 1, B: 1}
+
+	minContrast := color.ContrastRatio(color.NewFromHex(w.Foreground), bg)
+	ansi := w.ANSIColors()
+	for _, i := range []int{1, 2, 3, 4, 5, 6, 9, 10, 11, 12, 13, 14} {
+		minContrast = math.Min(minContrast, color.ContrastRatio(color.NewFromHex(ansi[i]), bg))
+	}
+
+	round := func(f float64) float64 { return math.Round(f*1000) / 1000 }
+
+	return Info{
+		Name:                name,
+		Dark:                color.ContrastRatio(bg, white) > color.ContrastRatio(bg, black),
+		BackgroundLuminance: round(l),
+		MinContrast:         round(minContrast),
+		Colors:              w,
+	}
+}
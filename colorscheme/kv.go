@@ -0,0 +1,153 @@
+package colorscheme
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Minimal YAML and TOML parsers that only handle what is needed for color
+// scheme files, nested maps with scalar values. Keys are flattened and joined
+// with "." and all values are returned as strings.
+
+// stripComment removes # comment not inside quotes. A # as first character of
+// a value is kept to allow unquoted colors like "key: #fff" in sloppy files.
+func stripComment(s string) string {
+	var quote rune
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			rest := strings.TrimSpace(s[:i])
+			if strings.HasSuffix(rest, ":") || strings.HasSuffix(rest, "=") {
+				continue
+			}
+			return s[:i]
+		}
+	}
+	return s
+}
+
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' && s[len(s)-1] == '"') {
+		if u, err := strconv.Unquote(s); err == nil {
+			return u
+		}
+	}
+	if len(s) >= 2 && (s[0] == '\'' && s[len(s)-1] == '\'') {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+	return s
+}
+
+func parseYAML(b []byte) (map[string]string, error) {
+	kv := map[string]string{}
+	type level struct {
+		indent int
+		key    string
+	}
+	var stack []level
+
+	s := bufio.NewScanner(bytes.NewReader(b))
+	lineNr := 0
+	for s.Scan() {
+		lineNr++
+		raw := strings.TrimRight(stripComment(s.Text()), " \t\r")
+		l := strings.TrimLeft(raw, " ")
+		if l == "" || l == "---" || strings.HasPrefix(l, "- ") || l == "-" {
+			continue
+		}
+		indent := len(raw) - len(l)
+
+		k, v, ok := strings.Cut(l, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key: value", lineNr)
+		}
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		var path []string
+		for _, l := range stack {
+			path = append(path, l.key)
+		}
+		path = append(path, unquote(k))
+
+		v = strings.TrimSpace(v)
+		if v == "" {
+			stack = append(stack, level{indent: indent, key: unquote(k)})
+			continue
+		}
+		kv[strings.Join(path, ".")] = unquote(v)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return kv, nil
+}
+
+func parseTOML(b []byte) (map[string]string, error) {
+	kv := map[string]string{}
+	var table string
+	skipTable := false
+
+	s := bufio.NewScanner(bytes.NewReader(b))
+	lineNr := 0
+	for s.Scan() {
+		lineNr++
+		l := strings.TrimSpace(stripComment(s.Text()))
+		switch {
+		case l == "":
+			continue
+		case strings.HasPrefix(l, "[["):
+			// array of tables, ex alacritty indexed_colors, not used
+			skipTable = true
+			continue
+		case strings.HasPrefix(l, "["):
+			if !strings.HasSuffix(l, "]") {
+				return nil, fmt.Errorf("line %d: invalid table", lineNr)
+			}
+			table = strings.TrimSpace(l[1 : len(l)-1])
+			skipTable = false
+			continue
+		case skipTable:
+			continue
+		}
+
+		k, v, ok := strings.Cut(l, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNr)
+		}
+		k = unquote(k)
+		if table != "" {
+			k = table + "." + k
+		}
+		v = strings.TrimSpace(v)
+
+		// inline table, key = { a = "1", b = "2" }
+		if strings.HasPrefix(v, "{") && strings.HasSuffix(v, "}") {
+			for _, p := range strings.Split(v[1:len(v)-1], ",") {
+				ik, iv, ok := strings.Cut(p, "=")
+				if !ok {
+					continue
+				}
+				kv[k+"."+unquote(ik)] = unquote(iv)
+			}
+			continue
+		}
+		kv[k] = unquote(v)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return kv, nil
+}
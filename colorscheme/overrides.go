@@ -0,0 +1,79 @@
+package colorscheme
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wader/ansisvg/color"
+)
+
+// Overrides replaces individual colors of a color scheme. Colors can be any
+// format supported by color.Parse, empty string means no override.
+type Overrides struct {
+	Foreground string
+	Background string
+	Cursor     string
+	Selection  string
+	ANSI       [16]string
+}
+
+// ANSIColorIndex returns ANSI color index for 0-15 or a name like "red" or "bright-red"
+func ANSIColorIndex(s string) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 || n > 15 {
+			return 0, fmt.Errorf("%q: ANSI color must be 0-15", s)
+		}
+		return n, nil
+	}
+	n := strings.NewReplacer("-", "", "_", "", " ", "").Replace(strings.ToLower(s))
+	offset := 0
+	if strings.HasPrefix(n, "bright") {
+		n = n[len("bright"):]
+		offset = 8
+	}
+	for i, an := range ansiNames {
+		if n == an {
+			return i + offset, nil
+		}
+	}
+	return 0, fmt.Errorf("%q: unknown ANSI color, should be 0-15 or name like red or bright-red", s)
+}
+
+// Apply returns w with overrides applied, fails if a override is not a valid color
+func (o Overrides) Apply(w WorkbenchColorCustomizations) (WorkbenchColorCustomizations, error) {
+	set := func(name string, dst *string, s string) error {
+		if s == "" {
+			return nil
+		}
+		c, err := color.Parse(s)
+		if err != nil {
+			return fmt.Errorf("%s color: %w", name, err)
+		}
+		*dst = c.Hex()
+		return nil
+	}
+
+	ansi := w.ansiColorPtrs()
+	for i, s := range o.ANSI {
+		if err := set(fmt.Sprintf("ANSI %d", i), ansi[i], s); err != nil {
+			return w, err
+		}
+	}
+	for _, c := range []struct {
+		name string
+		dst  *string
+		s    string
+	}{
+		{name: "foreground", dst: &w.Foreground, s: o.Foreground},
+		{name: "background", dst: &w.Background, s: o.Background},
+		{name: "cursor", dst: &w.CursorForeground, s: o.Cursor},
+		{name: "selection", dst: &w.SelectionBackground, s: o.Selection},
+	} {
+		if err := set(c.name, c.dst, c.s); err != nil {
+			return w, err
+		}
+	}
+
+	return w, nil
+}
@@ -0,0 +1,75 @@
+package colorscheme
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestANSIColorIndex(t *testing.T) {
+	for _, tc := range []struct {
+		s        string
+		expected int
+		err      string
+	}{
+		{s: "0", expected: 0},
+		{s: "15", expected: 15},
+		{s: "16", err: `"16": ANSI color must be 0-15`},
+		{s: "-1", err: `"-1": ANSI color must be 0-15`},
+		{s: "red", expected: 1},
+		{s: "Bright-Red", expected: 9},
+		{s: "bright_blue", expected: 12},
+		{s: "brightwhite", expected: 15},
+		{s: "purple", err: `"purple": unknown ANSI color`},
+		{s: "bright", err: `"bright": unknown ANSI color`},
+		{s: "", err: `"": unknown ANSI color`},
+	} {
+		actual, err := ANSIColorIndex(tc.s)
+		if tc.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), tc.err) {
+				t.Errorf("%q: expected error %q, got %d %v", tc.s, tc.err, actual, err)
+			}
+			continue
+		}
+		if err != nil || actual != tc.expected {
+			t.Errorf("%q: expected %d, got %d %v", tc.s, tc.expected, actual, err)
+		}
+	}
+}
+
+func TestOverridesApply(t *testing.T) {
+	w := WorkbenchColorCustomizations{
+		Foreground: "#c0c0c0",
+		Background: "#101010",
+		ANSIRed:    "#aa0000",
+	}
+
+	var o Overrides
+	o.Foreground = "white"
+	o.Selection = "rgb(0, 0, 255)"
+	o.ANSI[1] = "#f00"
+	o.ANSI[9] = "#00ff00"
+	actual, err := o.Apply(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual.Foreground != "#ffffff" || actual.Background != "#101010" || actual.SelectionBackground != "#0000ff" ||
+		actual.ANSIRed != "#ff0000" || actual.ANSIBrightRed != "#00ff00" || actual.CursorForeground != "" {
+		t.Errorf("unexpected colors %+v", actual)
+	}
+	if w.Foreground != "#c0c0c0" {
+		t.Errorf("expected scheme to not be modified")
+	}
+
+	for _, tc := range []struct {
+		o   Overrides
+		err string
+	}{
+		{o: Overrides{Background: "nope"}, err: "background color: "},
+		{o: Overrides{Cursor: "#12"}, err: "cursor color: "},
+		{o: Overrides{ANSI: [16]string{3: "nope"}}, err: "ANSI 3 color: "},
+	} {
+		if _, err := tc.o.Apply(w); err == nil || !strings.HasPrefix(err.Error(), tc.err) {
+			t.Errorf("%+v: expected error %q, got %v", tc.o, tc.err, err)
+		}
+	}
+}
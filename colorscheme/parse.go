@@ -0,0 +1,177 @@
+package colorscheme
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/wader/ansisvg/color"
+)
+
+type Format string
+
+const (
+	FormatVSCode          Format = "vscode"
+	FormatITerm2          Format = "itermcolors"
+	FormatAlacrittyTOML   Format = "alacritty-toml"
+	FormatAlacrittyYAML   Format = "alacritty-yaml"
+	FormatKitty           Format = "kitty"
+	FormatWindowsTerminal Format = "windowsterminal"
+	FormatXresources      Format = "xresources"
+	FormatBase16          Format = "base16"
+)
+
+var kittyColorRe = regexp.MustCompile(`(?m)^\s*(foreground|background|color\d+)\s+\S`)
+var xresourcesColorRe = regexp.MustCompile(`(?m)^\s*[\w.*-]*[.*](foreground|background|color\d+)\s*:`)
+var base16Re = regexp.MustCompile(`(?m)^\s*base0[0-9A-Fa-f]\s*:`)
+
+// DetectFormat guesses color scheme format based on file name and content
+func DetectFormat(name string, b []byte) (Format, error) {
+	trimmed := bytes.TrimSpace(b)
+	switch ext := strings.ToLower(filepath.Ext(name)); {
+	case ext == ".itermcolors":
+		return FormatITerm2, nil
+	case ext == ".toml":
+		return FormatAlacrittyTOML, nil
+	case ext == ".yml" || ext == ".yaml":
+		if base16Re.Match(b) {
+			return FormatBase16, nil
+		}
+		return FormatAlacrittyYAML, nil
+	case ext == ".conf":
+		return FormatKitty, nil
+	case ext == ".json" || bytes.HasPrefix(trimmed, []byte("{")):
+		if bytes.Contains(b, []byte(`"workbench.colorCustomizations"`)) {
+			return FormatVSCode, nil
+		}
+		return FormatWindowsTerminal, nil
+	case ext == ".xresources" || ext == ".xdefaults" ||
+		strings.HasPrefix(strings.ToLower(filepath.Base(name)), ".xresources"):
+		return FormatXresources, nil
+	case bytes.HasPrefix(trimmed, []byte("<?xml")) || bytes.HasPrefix(trimmed, []byte("<plist")):
+		return FormatITerm2, nil
+	case base16Re.Match(b):
+		return FormatBase16, nil
+	case bytes.Contains(b, []byte("[colors")):
+		return FormatAlacrittyTOML, nil
+	case xresourcesColorRe.Match(b):
+		return FormatXresources, nil
+	case kittyColorRe.Match(b):
+		return FormatKitty, nil
+	}
+	return "", fmt.Errorf("%s: unknown color scheme format", name)
+}
+
+// Parse parses a color scheme file, format is detected using DetectFormat.
+// Supported formats are VS Code settings JSON, iTerm2 .itermcolors, Alacritty
+// TOML and YAML, kitty .conf, Windows Terminal JSON, Xresources and base16/base24 YAML.
+func Parse(name string, b []byte) (WorkbenchColorCustomizations, error) {
+	f, err := DetectFormat(name, b)
+	if err != nil {
+		return WorkbenchColorCustomizations{}, err
+	}
+	return ParseFormat(f, b)
+}
+
+// ParseFormat parses a color scheme in a specific format
+func ParseFormat(f Format, b []byte) (WorkbenchColorCustomizations, error) {
+	var w WorkbenchColorCustomizations
+	var err error
+	switch f {
+	case FormatVSCode:
+		w, err = parseVSCode(b)
+	case FormatITerm2:
+		w, err = parseITerm2(b)
+	case FormatAlacrittyTOML:
+		var kv map[string]string
+		if kv, err = parseTOML(b); err == nil {
+			w = alacrittyFromKV(kv)
+		}
+	case FormatAlacrittyYAML:
+		var kv map[string]string
+		if kv, err = parseYAML(b); err == nil {
+			w = alacrittyFromKV(kv)
+		}
+	case FormatKitty:
+		w = parseKitty(b)
+	case FormatWindowsTerminal:
+		w, err = parseWindowsTerminal(b)
+	case FormatXresources:
+		w = parseXresources(b)
+	case FormatBase16:
+		var kv map[string]string
+		if kv, err = parseYAML(b); err == nil {
+			w = base16FromKV(kv)
+		}
+	default:
+		return w, fmt.Errorf("%s: unknown color scheme format", f)
+	}
+	if err != nil {
+		return w, fmt.Errorf("%s: %w", f, err)
+	}
+	if err := w.Normalize(); err != nil {
+		return w, fmt.Errorf("%s: %w", f, err)
+	}
+	return w, nil
+}
+
+// Normalize makes sure all colors are #rrggbb, fills in optional colors
+// and fails if a color is invalid or a required color is missing
+func (w *WorkbenchColorCustomizations) Normalize() error {
+	ansi := w.ansiColorPtrs()
+	for i := 0; i < 8; i++ {
+		if *ansi[i+8] == "" {
+			*ansi[i+8] = *ansi[i]
+		}
+	}
+	if w.CursorForeground == "" {
+		w.CursorForeground = w.Foreground
+	}
+
+	var missing []string
+	for _, c := range []struct {
+		name     string
+		v        *string
+		optional bool
+	}{
+		{name: "foreground", v: &w.Foreground},
+		{name: "background", v: &w.Background},
+		{name: "black", v: ansi[0]},
+		{name: "red", v: ansi[1]},
+		{name: "green", v: ansi[2]},
+		{name: "yellow", v: ansi[3]},
+		{name: "blue", v: ansi[4]},
+		{name: "magenta", v: ansi[5]},
+		{name: "cyan", v: ansi[6]},
+		{name: "white", v: ansi[7]},
+		{name: "bright black", v: ansi[8]},
+		{name: "bright red", v: ansi[9]},
+		{name: "bright green", v: ansi[10]},
+		{name: "bright yellow", v: ansi[11]},
+		{name: "bright blue", v: ansi[12]},
+		{name: "bright magenta", v: ansi[13]},
+		{name: "bright cyan", v: ansi[14]},
+		{name: "bright white", v: ansi[15]},
+		{name: "selection", v: &w.SelectionBackground, optional: true},
+		{name: "cursor", v: &w.CursorForeground, optional: true},
+	} {
+		if *c.v == "" {
+			if !c.optional {
+				missing = append(missing, c.name)
+			}
+			continue
+		}
+		pc, err := color.Parse(*c.v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.name, err)
+		}
+		*c.v = pc.Hex()
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing colors: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
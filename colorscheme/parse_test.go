@@ -0,0 +1,200 @@
+package colorscheme
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// kittyScheme returns a kitty color scheme with all required colors and extra lines
+func kittyScheme(extra string) string {
+	var sb strings.Builder
+	sb.WriteString("foreground #c0c0c0\nbackground #101010\n")
+	for i := 0; i < 16; i++ {
+		fmt.Fprintf(&sb, "color%d #%02x0000\n", i, i)
+	}
+	sb.WriteString(extra)
+	return sb.String()
+}
+
+func TestDetectFormat(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		content  string
+		expected Format
+	}{
+		{"a.itermcolors", "", FormatITerm2},
+		{"a.toml", "", FormatAlacrittyTOML},
+		{"a.yml", "colors:\n", FormatAlacrittyYAML},
+		{"a.yaml", "base00: \"000000\"\n", FormatBase16},
+		{"kitty.conf", "", FormatKitty},
+		{"a.json", `{"workbench.colorCustomizations": {}}`, FormatVSCode},
+		{"a.json", `{"schemes": []}`, FormatWindowsTerminal},
+		{"scheme", ` {"foreground": "#fff"}`, FormatWindowsTerminal},
+		{".Xresources", "", FormatXresources},
+		{".Xresources.dark", "", FormatXresources},
+		{"scheme", "<?xml version=\"1.0\"?>\n<plist>", FormatITerm2},
+		{"scheme", "scheme: x\nbase0A: \"ff0000\"\n", FormatBase16},
+		{"scheme", "[colors.primary]\n", FormatAlacrittyTOML},
+		{"scheme", "URxvt*color0: #000000\n", FormatXresources},
+		{"scheme", "color0 #000000\n", FormatKitty},
+	} {
+		actual, err := DetectFormat(tc.name, []byte(tc.content))
+		if err != nil || actual != tc.expected {
+			t.Errorf("%s %q: expected %s, got %s %v", tc.name, tc.content, tc.expected, actual, err)
+		}
+	}
+
+	for _, content := range []string{"", "hello\n", "colorX #000000\n"} {
+		if f, err := DetectFormat("scheme", []byte(content)); err == nil {
+			t.Errorf("%q: expected error, got %s", content, f)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		format  Format
+		content string
+		err     string
+		check   func(t *testing.T, w WorkbenchColorCustomizations)
+	}{
+		{
+			name:    "kitty",
+			format:  FormatKitty,
+			content: kittyScheme("cursor #ff0000\nselection_background #00f\n"),
+			check: func(t *testing.T, w WorkbenchColorCustomizations) {
+				if w.Foreground != "#c0c0c0" || w.ANSIBrightWhite != "#0f0000" ||
+					w.CursorForeground != "#ff0000" || w.SelectionBackground != "#0000ff" {
+					t.Errorf("unexpected colors %+v", w)
+				}
+			},
+		},
+		{
+			name:    "unknown keys and out of range indexes are ignored",
+			format:  FormatKitty,
+			content: kittyScheme("font_size 12\ncolor16 nope\ncolor-1 nope\ncolorX nope\n# foreground nope\n"),
+			check: func(t *testing.T, w WorkbenchColorCustomizations) {
+				if w.Foreground != "#c0c0c0" {
+					t.Errorf("unexpected foreground %s", w.Foreground)
+				}
+			},
+		},
+		{
+			name:    "bright and cursor default to normal and foreground",
+			format:  FormatXresources,
+			content: "#define fg #eeeeee\n*.foreground: fg\n*.background: #000\n*.color0: #000\n*.color1: #100\n*.color2: #200\n*.color3: #300\n*.color4: #400\n*.color5: #500\n*.color6: #600\n*.color7: #700\n*.color99: nope\n",
+			check: func(t *testing.T, w WorkbenchColorCustomizations) {
+				if w.ANSIBrightRed != "#110000" || w.CursorForeground != "#eeeeee" || w.SelectionBackground != "" {
+					t.Errorf("unexpected colors %+v", w)
+				}
+			},
+		},
+		{
+			name:    "base16 without #",
+			format:  FormatBase16,
+			content: "palette:\n  base00: \"000000\"\n  base02: \"222222\"\n  base03: \"333333\"\n  base05: \"555555\"\n  base07: \"777777\"\n  base08: \"888888\"\n  base0A: \"aaaaaa\"\n  base0B: \"bbbbbb\"\n  base0C: \"cccccc\"\n  base0D: \"dddddd\"\n  base0E: \"eeeeee\"\n",
+			check: func(t *testing.T, w WorkbenchColorCustomizations) {
+				if w.Background != "#000000" || w.ANSIRed != "#888888" || w.ANSIBrightBlack != "#333333" {
+					t.Errorf("unexpected colors %+v", w)
+				}
+			},
+		},
+		{name: "missing colors", format: FormatKitty, content: "foreground #fff\ncolor1 #f00\n", err: "kitty: missing colors: background, black, green"},
+		{name: "invalid color", format: FormatKitty, content: kittyScheme("color3 nope\n"), err: "kitty: yellow: "},
+		{name: "invalid toml table", format: FormatAlacrittyTOML, content: "[colors.primary\n", err: "alacritty-toml: line 1: invalid table"},
+		{name: "invalid toml line", format: FormatAlacrittyTOML, content: "[colors]\nnope\n", err: "alacritty-toml: line 2: expected key = value"},
+		{name: "invalid yaml line", format: FormatAlacrittyYAML, content: "colors:\n  nope\n", err: "alacritty-yaml: line 2: expected key: value"},
+		{name: "invalid vscode json", format: FormatVSCode, content: "{", err: "vscode: "},
+		{name: "invalid windows terminal json", format: FormatWindowsTerminal, content: "[1]", err: "windowsterminal: "},
+		{name: "invalid iterm2 xml", format: FormatITerm2, content: "<plist><dict>", err: "itermcolors: "},
+		{
+			name:    "invalid iterm2 component",
+			format:  FormatITerm2,
+			content: "<plist><dict><key>Ansi 0 Color</key><dict><key>Red Component</key><real>x</real></dict></dict></plist>",
+			err:     "itermcolors: Ansi 0 Color: Red Component: ",
+		},
+		{name: "unknown format", format: "nope", err: "nope: unknown color scheme format"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := ParseFormat(tc.format, []byte(tc.content))
+			if tc.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.err) {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.check(t, w)
+		})
+	}
+}
+
+func TestParseKV(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		fn       func(b []byte) (map[string]string, error)
+		content  string
+		expected map[string]string
+	}{
+		{
+			name: "yaml",
+			fn:   parseYAML,
+			content: `---
+colors:
+  primary:
+    background: '#1d1f21' # comment
+    foreground: "#c5c8c6"
+  cursor:
+    cursor: #ffffff
+  indexed_colors:
+    - index: 16
+name: 'it''s'
+`,
+			expected: map[string]string{
+				"colors.primary.background": "#1d1f21",
+				"colors.primary.foreground": "#c5c8c6",
+				"colors.cursor.cursor":      "#ffffff",
+				"name":                      "it's",
+			},
+		},
+		{
+			name: "toml",
+			fn:   parseTOML,
+			content: `# comment
+[colors.primary]
+background = "#1d1f21" # comment
+foreground = '#c5c8c6'
+
+[[colors.indexed_colors]]
+index = 16
+color = "#ff0000"
+
+[colors]
+cursor = { text = "#000000", cursor = "#ffffff" }
+`,
+			expected: map[string]string{
+				"colors.primary.background": "#1d1f21",
+				"colors.primary.foreground": "#c5c8c6",
+				"colors.cursor.text":        "#000000",
+				"colors.cursor.cursor":      "#ffffff",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := tc.fn([]byte(tc.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
+	"unicode"
 
 	"github.com/wader/ansisvg/colorscheme"
 )
@@ -15,11 +17,131 @@ var fs embed.FS
 
 const jsonExt = ".json"
 
+// NotFoundError is returned when no scheme matches, Suggestions are similar names
+type NotFoundError struct {
+	Name        string
+	Suggestions []string
+}
+
+func (e NotFoundError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("scheme not found: %q", e.Name)
+	}
+	var qs []string
+	for _, s := range e.Suggestions {
+		qs = append(qs, fmt.Sprintf("%q", s))
+	}
+	return fmt.Sprintf("scheme not found: %q, did you mean %s?", e.Name, strings.Join(qs, ", "))
+}
+
+// normalize lower cases and removes everything but letters and digits
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j] + 1
+			if cur[j-1]+1 < cur[j] {
+				cur[j] = cur[j-1] + 1
+			}
+			if prev[j-1]+cost < cur[j] {
+				cur[j] = prev[j-1] + cost
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// Find returns the name of the scheme matching name. Tries exact match, case-insensitive
+// match, match ignoring everything but letters and digits, ex "tomorrow-night" matches
+// "Tomorrow Night", and then a unique substring match. Returns NotFoundError with
+// suggestions if nothing matches.
+func Find(name string) (string, error) {
+	return find(name, Names())
+}
+
+// find matches name against names ns, see Find
+func find(name string, ns []string) (string, error) {
+	for _, n := range ns {
+		if n == name {
+			return n, nil
+		}
+	}
+	for _, n := range ns {
+		if strings.EqualFold(n, name) {
+			return n, nil
+		}
+	}
+	nn := normalize(name)
+	for _, n := range ns {
+		if normalize(n) == nn {
+			return n, nil
+		}
+	}
+
+	type scored struct {
+		name  string
+		score int
+	}
+	var candidates []scored
+	for _, n := range ns {
+		nnn := normalize(n)
+		switch {
+		case nn != "" && strings.Contains(nnn, nn):
+			candidates = append(candidates, scored{name: n, score: len(nnn) - len(nn)})
+		default:
+			d := levenshtein(nn, nnn)
+			maxDistance := len(nn) / 3
+			if maxDistance < 2 {
+				maxDistance = 2
+			}
+			if d <= maxDistance {
+				candidates = append(candidates, scored{name: n, score: d})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score < candidates[j].score })
+	if len(candidates) == 1 && strings.Contains(normalize(candidates[0].name), nn) {
+		return candidates[0].name, nil
+	}
+
+	const maxSuggestions = 3
+	err := NotFoundError{Name: name}
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		err.Suggestions = append(err.Suggestions, candidates[i].name)
+	}
+	return "", err
+}
+
+// Load loads scheme by name, see Find for how name is matched
 func Load(name string) (colorscheme.WorkbenchColorCustomizations, error) {
 	var vsCS colorscheme.VSCodeColorScheme
-	f, err := fs.Open(name + jsonExt)
+	n, err := Find(name)
+	if err != nil {
+		return vsCS.WorkbenchColorCustomizations, err
+	}
+	f, err := fs.Open(n + jsonExt)
 	if err != nil {
-		return vsCS.WorkbenchColorCustomizations, fmt.Errorf("scheme not found")
+		return vsCS.WorkbenchColorCustomizations, err
 	}
 	defer f.Close()
 	if err := json.NewDecoder(f).Decode(&vsCS); err != nil {
@@ -45,3 +167,16 @@ func Names() []string {
 
 	return ns
 }
+
+// Infos returns metadata for all schemes sorted by name
+func Infos() ([]colorscheme.Info, error) {
+	var is []colorscheme.Info
+	for _, n := range Names() {
+		s, err := Load(n)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", n, err)
+		}
+		is = append(is, s.Info(n))
+	}
+	return is, nil
+}
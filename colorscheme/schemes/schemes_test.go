@@ -0,0 +1,91 @@
+package schemes
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"abc", "abc", 0},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"åäö", "aäö", 1},
+	} {
+		if actual := levenshtein(tc.a, tc.b); actual != tc.expected {
+			t.Errorf("levenshtein(%q, %q): expected %d, got %d", tc.a, tc.b, tc.expected, actual)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	ns := []string{
+		"Dracula",
+		"Dracula+",
+		"Nord",
+		"Nord Light",
+		"Tomorrow",
+		"Tomorrow Night",
+		"Tomorrow Night Blue",
+		"Tomorrow Night Bright",
+	}
+	for _, tc := range []struct {
+		name        string
+		expected    string
+		suggestions []string
+	}{
+		{name: "Nord", expected: "Nord"},
+		{name: "nord light", expected: "Nord Light"},
+		{name: "tomorrow-night", expected: "Tomorrow Night"},
+		// unique substring
+		{name: "blue", expected: "Tomorrow Night Blue"},
+		// ambiguous substring, shortest name first
+		{name: "night", suggestions: []string{"Tomorrow Night", "Tomorrow Night Blue", "Tomorrow Night Bright"}},
+		// only 3 best suggestions
+		{name: "omorrow", suggestions: []string{"Tomorrow", "Tomorrow Night", "Tomorrow Night Blue"}},
+		// a single typo match is a suggestion, not a match
+		{name: "nrd", suggestions: []string{"Nord"}},
+		// ties keep name order
+		{name: "dracla", suggestions: []string{"Dracula", "Dracula+"}},
+		{name: "zzzzzzzz", suggestions: nil},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := find(tc.name, ns)
+			if tc.expected != "" {
+				if err != nil || actual != tc.expected {
+					t.Fatalf("expected %q, got %q %v", tc.expected, actual, err)
+				}
+				return
+			}
+			var nfe NotFoundError
+			if !errors.As(err, &nfe) {
+				t.Fatalf("expected NotFoundError, got %q %v", actual, err)
+			}
+			if !reflect.DeepEqual(tc.suggestions, nfe.Suggestions) {
+				t.Errorf("expected suggestions %q, got %q", tc.suggestions, nfe.Suggestions)
+			}
+			if len(tc.suggestions) > 0 && !strings.Contains(err.Error(), "did you mean") {
+				t.Errorf("expected suggestions in error, got %q", err)
+			}
+		})
+	}
+}
+
+func TestInfos(t *testing.T) {
+	is, err := Infos()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(is) != len(Names()) {
+		t.Errorf("expected %d infos, got %d", len(Names()), len(is))
+	}
+}
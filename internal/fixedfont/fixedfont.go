@@ -0,0 +1,33 @@
+// Package fixedfont is a 7x13 bitmap font derived from the public domain X11
+// misc-fixed font. Has glyphs for ASCII, Latin-1, Latin Extended-A, general
+// punctuation, arrows, math operators, box drawing, block elements and some symbols.
+package fixedfont
+
+import (
+	"image"
+	"sort"
+)
+
+const (
+	Width  = 7
+	Height = 13
+	// Ascent is baseline offset from top of glyph
+	Ascent = 11
+)
+
+// Glyph returns glyph mask for r with bounds (0, 0, Width, Height)
+func Glyph(r rune) (*image.Alpha, bool) {
+	i := sort.Search(len(glyphRunes), func(i int) bool { return glyphRunes[i] >= r })
+	if i == len(glyphRunes) || glyphRunes[i] != r {
+		return nil, false
+	}
+	m := image.NewAlpha(image.Rect(0, 0, Width, Height))
+	for y, row := range glyphBits[i*Height : (i+1)*Height] {
+		for x := 0; x < Width; x++ {
+			if row&(0x80>>x) != 0 {
+				m.Pix[y*m.Stride+x] = 0xff
+			}
+		}
+	}
+	return m, true
+}
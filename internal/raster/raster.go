@@ -0,0 +1,163 @@
+// Package raster is a anti-aliased rasterizer for paths of lines and quadratic
+// curves. Coverage is computed using signed area accumulation, each line adds
+// its exact area contribution to the cells it crosses and a running sum
+// along each row gives coverage. Coverage is clamped so overlapping contours
+// with same winding are filled like with the nonzero rule.
+package raster
+
+import (
+	"image"
+	"math"
+)
+
+type point struct{ x, y float32 }
+
+type Rasterizer struct {
+	w, h  int
+	acc   []float32
+	first point
+	pen   point
+}
+
+// New returns a rasterizer for a w x h mask, origin is top left and y grows down
+func New(w, h int) *Rasterizer {
+	return &Rasterizer{
+		w:   w,
+		h:   h,
+		acc: make([]float32, w*h+2),
+	}
+}
+
+func (r *Rasterizer) MoveTo(x, y float32) {
+	r.first = point{x, y}
+	r.pen = r.first
+}
+
+func (r *Rasterizer) LineTo(x, y float32) {
+	p := point{x, y}
+	r.line(r.pen, p)
+	r.pen = p
+}
+
+// QuadTo adds quadratic bezier curve, flattened into lines
+func (r *Rasterizer) QuadTo(cx, cy, x, y float32) {
+	p0 := r.pen
+	dx := p0.x - 2*cx + x
+	dy := p0.y - 2*cy + y
+	dd := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	n := 1 + int(math.Sqrt(float64(dd)*2))
+	if n > 32 {
+		n = 32
+	}
+	for i := 1; i <= n; i++ {
+		t := float32(i) / float32(n)
+		mt := 1 - t
+		r.LineTo(
+			mt*mt*p0.x+2*mt*t*cx+t*t*x,
+			mt*mt*p0.y+2*mt*t*cy+t*t*y,
+		)
+	}
+}
+
+// Close closes current contour
+func (r *Rasterizer) Close() {
+	if r.pen != r.first {
+		r.LineTo(r.first.x, r.first.y)
+	}
+}
+
+func clamp(v, lo, hi float32) float32 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+func (r *Rasterizer) line(p0, p1 point) {
+	if p0.y == p1.y {
+		return
+	}
+	// area left of the mask still affects coverage so clamp x instead of clipping
+	p0.x = clamp(p0.x, 0, float32(r.w))
+	p1.x = clamp(p1.x, 0, float32(r.w))
+	dir := float32(1)
+	if p0.y > p1.y {
+		dir = -1
+		p0, p1 = p1, p0
+	}
+	dxdy := (p1.x - p0.x) / (p1.y - p0.y)
+	x := p0.x
+	if p0.y < 0 {
+		x -= p0.y * dxdy
+	}
+	yStart := int(p0.y)
+	if p0.y < 0 {
+		yStart = 0
+	}
+	yEnd := int(math.Ceil(float64(p1.y)))
+	if yEnd > r.h {
+		yEnd = r.h
+	}
+	for y := yStart; y < yEnd; y++ {
+		ls := y * r.w
+		dy := float32(math.Min(float64(y+1), float64(p1.y)) - math.Max(float64(y), float64(p0.y)))
+		xNext := x + dxdy*dy
+		d := dy * dir
+		x0, x1 := x, xNext
+		if x0 > x1 {
+			x0, x1 = x1, x0
+		}
+		x0Floor := float32(math.Floor(float64(x0)))
+		x0i := int(x0Floor)
+		x1Ceil := float32(math.Ceil(float64(x1)))
+		x1i := int(x1Ceil)
+		if x1i <= x0i+1 {
+			xmf := 0.5*(x+xNext) - x0Floor
+			r.acc[ls+x0i] += d - d*xmf
+			r.acc[ls+x0i+1] += d * xmf
+		} else {
+			s := 1 / (x1 - x0)
+			x0f := x0 - x0Floor
+			a0 := 0.5 * s * (1 - x0f) * (1 - x0f)
+			x1f := x1 - x1Ceil + 1
+			am := 0.5 * s * x1f * x1f
+			r.acc[ls+x0i] += d * a0
+			if x1i == x0i+2 {
+				r.acc[ls+x0i+1] += d * (1 - a0 - am)
+			} else {
+				a1 := s * (1.5 - x0f)
+				r.acc[ls+x0i+1] += d * (a1 - a0)
+				for xi := x0i + 2; xi < x1i-1; xi++ {
+					r.acc[ls+xi] += d * s
+				}
+				a2 := a1 + float32(x1i-x0i-3)*s
+				r.acc[ls+x1i-1] += d * (1 - a2 - am)
+			}
+			r.acc[ls+x1i] += d * am
+		}
+		x = xNext
+	}
+}
+
+// Mask returns coverage as an alpha mask
+func (r *Rasterizer) Mask() *image.Alpha {
+	m := image.NewAlpha(image.Rect(0, 0, r.w, r.h))
+	// contributions of a closed path sums to zero for each row, area right of
+	// the last column spills into first column of the next row and cancels out
+	var sum float32
+	for i := 0; i < r.w*r.h; i++ {
+		sum += r.acc[i]
+		a := sum
+		if a < 0 {
+			a = -a
+		}
+		if a > 1 {
+			a = 1
+		}
+		m.Pix[i] = uint8(a*255 + 0.5)
+	}
+	return m
+}
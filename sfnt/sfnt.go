@@ -0,0 +1,561 @@
+// Package sfnt parses TrueType and OpenType font files, only what is needed to
+// render and measure glyphs. Glyph outlines are only supported for TrueType
+// (glyf table) fonts, not CFF.
+package sfnt
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrCFFNotSupported = errors.New("CFF outlines not supported")
+
+// Font is a parsed font file. Metrics are in font units, see UnitsPerEm.
+type Font struct {
+	UnitsPerEm int
+	// Ascender, Descender and LineGap from hhea, descender is negative
+	Ascender  int
+	Descender int
+	LineGap   int
+	// UnderlinePosition is top of underline relative to baseline, usually negative
+	UnderlinePosition  int
+	UnderlineThickness int
+	// StrikeoutPosition is bottom of strikeout relative to baseline, 0 if unknown
+	StrikeoutPosition int
+	StrikeoutSize     int
+	// CapHeight from OS/2 version 2 or later, 0 if unknown
+	CapHeight int
+	// Bounding box of all glyphs
+	XMin, YMin, XMax, YMax int
+	NumGlyphs              int
+	// CFF is true if glyph outlines are CFF (OpenType .otf) instead of TrueType
+	CFF bool
+
+	tables           map[string][]byte
+	numHMetrics      int
+	indexToLocFormat int
+	cmap             map[rune]uint16
+}
+
+// Point is a point of a TrueType contour, off curve points are quadratic
+// bezier control points and two off curve points in a row have an implicit on
+// curve point in between
+type Point struct {
+	X, Y    float32
+	OnCurve bool
+}
+
+type Contour []Point
+
+func u16(b []byte, o int) int { return int(binary.BigEndian.Uint16(b[o:])) }
+func i16(b []byte, o int) int { return int(int16(binary.BigEndian.Uint16(b[o:]))) }
+func u32(b []byte, o int) int { return int(binary.BigEndian.Uint32(b[o:])) }
+
+// Parse parses a TrueType (.ttf) or OpenType (.otf) font
+func Parse(b []byte) (*Font, error) {
+	if len(b) < 12 {
+		return nil, errors.New("font file too short")
+	}
+	switch string(b[0:4]) {
+	case "\x00\x01\x00\x00", "true", "OTTO":
+	case "wOFF", "wOF2":
+		return nil, errors.New("WOFF fonts not supported, use TTF or OTF")
+	case "ttcf":
+		return nil, errors.New("font collections not supported")
+	default:
+		return nil, errors.New("unknown font format")
+	}
+
+	f := &Font{tables: map[string][]byte{}}
+	numTables := u16(b, 4)
+	if len(b) < 12+numTables*16 {
+		return nil, errors.New("invalid table directory")
+	}
+	for i := 0; i < numTables; i++ {
+		e := b[12+i*16:]
+		tag := string(e[0:4])
+		offset := u32(e, 8)
+		length := u32(e, 12)
+		if offset+length > len(b) || offset+length < offset {
+			return nil, fmt.Errorf("%s: table outside of file", tag)
+		}
+		f.tables[tag] = b[offset : offset+length]
+	}
+
+	for _, t := range []string{"head", "hhea", "hmtx", "maxp", "cmap"} {
+		if _, ok := f.tables[t]; !ok {
+			return nil, fmt.Errorf("%s: missing table", t)
+		}
+	}
+
+	head := f.tables["head"]
+	if len(head) < 54 {
+		return nil, errors.New("head: table too short")
+	}
+	f.UnitsPerEm = u16(head, 18)
+	f.XMin, f.YMin, f.XMax, f.YMax = i16(head, 36), i16(head, 38), i16(head, 40), i16(head, 42)
+	f.indexToLocFormat = i16(head, 50)
+	if f.UnitsPerEm == 0 {
+		return nil, errors.New("head: invalid unitsPerEm")
+	}
+
+	hhea := f.tables["hhea"]
+	if len(hhea) < 36 {
+		return nil, errors.New("hhea: table too short")
+	}
+	f.Ascender = i16(hhea, 4)
+	f.Descender = i16(hhea, 6)
+	f.LineGap = i16(hhea, 8)
+	f.numHMetrics = u16(hhea, 34)
+
+	maxp := f.tables["maxp"]
+	if len(maxp) < 6 {
+		return nil, errors.New("maxp: table too short")
+	}
+	f.NumGlyphs = u16(maxp, 4)
+	if f.numHMetrics == 0 || len(f.tables["hmtx"]) < f.numHMetrics*4 {
+		return nil, errors.New("hmtx: table too short")
+	}
+
+	if post := f.tables["post"]; len(post) >= 12 {
+		f.UnderlinePosition = i16(post, 8)
+		f.UnderlineThickness = i16(post, 10)
+	}
+	if os2 := f.tables["OS/2"]; len(os2) >= 30 {
+		f.StrikeoutSize = i16(os2, 26)
+		f.StrikeoutPosition = i16(os2, 28)
+		if u16(os2, 0) >= 2 && len(os2) >= 90 {
+			f.CapHeight = i16(os2, 88)
+		}
+	}
+
+	_, f.CFF = f.tables["CFF "]
+
+	var err error
+	if f.cmap, err = parseCmap(f.tables["cmap"]); err != nil {
+		return nil, fmt.Errorf("cmap: %w", err)
+	}
+
+	return f, nil
+}
+
+// maxCmapRunes is max number of mapped runes, more than any real font but
+// stops a crafted cmap from mapping every code point many times
+const maxCmapRunes = 1 << 18
+
+// parseCmap parses the best unicode subtable, format 12 (full unicode) or format 4 (BMP)
+func parseCmap(b []byte) (map[rune]uint16, error) {
+	if len(b) < 4 {
+		return nil, errors.New("table too short")
+	}
+	type subtable struct {
+		format int
+		offset int
+	}
+	var best subtable
+	n := u16(b, 2)
+	for i := 0; i < n; i++ {
+		o := 4 + i*8
+		if o+8 > len(b) {
+			return nil, errors.New("table too short")
+		}
+		platform, encoding, offset := u16(b, o), u16(b, o+2), u32(b, o+4)
+		unicode := platform == 0 || (platform == 3 && (encoding == 1 || encoding == 10))
+		if !unicode || offset+2 > len(b) {
+			continue
+		}
+		format := u16(b, offset)
+		if (format == 12 && best.format != 12) || (format == 4 && best.format == 0) {
+			best = subtable{format: format, offset: offset}
+		}
+	}
+
+	m := map[rune]uint16{}
+	numRunes := 0
+	errTooMany := errors.New("too many mapped runes")
+	s := b[best.offset:]
+	switch best.format {
+	case 4:
+		if len(s) < 14 {
+			return nil, errors.New("format 4: too short")
+		}
+		segCount := u16(s, 6) / 2
+		if len(s) < 16+segCount*8 {
+			return nil, errors.New("format 4: too short")
+		}
+		endO, startO := 14, 16+segCount*2
+		deltaO, rangeO := startO+segCount*2, startO+segCount*4
+		for i := 0; i < segCount; i++ {
+			end, start := u16(s, endO+i*2), u16(s, startO+i*2)
+			delta, rangeOffset := u16(s, deltaO+i*2), u16(s, rangeO+i*2)
+			if numRunes += end - start + 1; numRunes > maxCmapRunes {
+				return nil, errTooMany
+			}
+			for c := start; c <= end && c != 0xffff; c++ {
+				var g int
+				if rangeOffset == 0 {
+					g = (c + delta) & 0xffff
+				} else {
+					o := rangeO + i*2 + rangeOffset + (c-start)*2
+					if o+2 > len(s) {
+						continue
+					}
+					if g = u16(s, o); g != 0 {
+						g = (g + delta) & 0xffff
+					}
+				}
+				if g != 0 {
+					m[rune(c)] = uint16(g)
+				}
+			}
+		}
+	case 12:
+		if len(s) < 16 {
+			return nil, errors.New("format 12: too short")
+		}
+		nGroups := u32(s, 12)
+		if len(s) < 16+nGroups*12 {
+			return nil, errors.New("format 12: too short")
+		}
+		for i := 0; i < nGroups; i++ {
+			o := 16 + i*12
+			start, end, g := u32(s, o), u32(s, o+4), u32(s, o+8)
+			if end < start || end > 0x10ffff {
+				return nil, fmt.Errorf("format 12: invalid group %#x-%#x", start, end)
+			}
+			if numRunes += end - start + 1; numRunes > maxCmapRunes {
+				return nil, errTooMany
+			}
+			for c := start; c <= end; c++ {
+				m[rune(c)] = uint16(g + c - start)
+			}
+		}
+	default:
+		return nil, errors.New("no supported unicode subtable")
+	}
+	return m, nil
+}
+
+// GlyphIndex returns glyph index for r, 0 (.notdef) if missing
+func (f *Font) GlyphIndex(r rune) uint16 {
+	return f.cmap[r]
+}
+
+// Runes returns all runes that has a glyph in sorted order
+func (f *Font) Runes() []rune {
+	rs := make([]rune, 0, len(f.cmap))
+	for r := range f.cmap {
+		rs = append(rs, r)
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i] < rs[j] })
+	return rs
+}
+
+// Advance returns horizontal advance width of glyph
+func (f *Font) Advance(gid uint16) int {
+	hmtx := f.tables["hmtx"]
+	i := int(gid)
+	if i >= f.numHMetrics {
+		i = f.numHMetrics - 1
+	}
+	return u16(hmtx, i*4)
+}
+
+// glyphData returns glyf table data for glyph, nil if glyph has no outline
+func (f *Font) glyphData(gid uint16) ([]byte, error) {
+	glyf, ok := f.tables["glyf"]
+	if !ok {
+		if f.CFF {
+			return nil, ErrCFFNotSupported
+		}
+		return nil, errors.New("glyf: missing table")
+	}
+	loca := f.tables["loca"]
+	i := int(gid)
+	if i >= f.NumGlyphs {
+		return nil, fmt.Errorf("glyph %d: out of range", gid)
+	}
+	var start, end int
+	if f.indexToLocFormat == 0 {
+		if len(loca) < (i+2)*2 {
+			return nil, errors.New("loca: table too short")
+		}
+		start, end = u16(loca, i*2)*2, u16(loca, i*2+2)*2
+	} else {
+		if len(loca) < (i+2)*4 {
+			return nil, errors.New("loca: table too short")
+		}
+		start, end = u32(loca, i*4), u32(loca, i*4+4)
+	}
+	if start == end {
+		return nil, nil
+	}
+	if start > end || end > len(glyf) {
+		return nil, fmt.Errorf("glyph %d: invalid location", gid)
+	}
+	return glyf[start:end], nil
+}
+
+// Contours returns outline of glyph in font units with y up
+func (f *Font) Contours(gid uint16) ([]Contour, error) {
+	components := 0
+	return f.contours(gid, 0, &components)
+}
+
+const maxCompositeDepth = 8
+
+// maxCompositeComponents is max number of components of a composite glyph
+// including nested components, limits work for components used many times
+const maxCompositeComponents = 1024
+
+func (f *Font) contours(gid uint16, depth int, components *int) ([]Contour, error) {
+	if depth > maxCompositeDepth {
+		return nil, errors.New("composite glyph too deep")
+	}
+	g, err := f.glyphData(gid)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	if len(g) < 10 {
+		return nil, fmt.Errorf("glyph %d: too short", gid)
+	}
+	numContours := i16(g, 0)
+	if numContours >= 0 {
+		return parseSimpleGlyph(g, numContours)
+	}
+	return f.parseCompositeGlyph(g, depth, components)
+}
+
+func parseSimpleGlyph(g []byte, numContours int) ([]Contour, error) {
+	errShort := errors.New("simple glyph: too short")
+	o := 10
+	if len(g) < o+numContours*2+2 {
+		return nil, errShort
+	}
+	endPts := make([]int, numContours)
+	for i := range endPts {
+		endPts[i] = u16(g, o+i*2)
+	}
+	o += numContours * 2
+	numPoints := 0
+	if numContours > 0 {
+		numPoints = endPts[numContours-1] + 1
+	}
+	instructionLength := u16(g, o)
+	o += 2 + instructionLength
+
+	const (
+		onCurve = 1 << iota
+		xShort
+		yShort
+		repeat
+		xSameOrPositive
+		ySameOrPositive
+	)
+	flags := make([]byte, 0, numPoints)
+	for len(flags) < numPoints {
+		if o >= len(g) {
+			return nil, errShort
+		}
+		fl := g[o]
+		o++
+		flags = append(flags, fl)
+		if fl&repeat != 0 {
+			if o >= len(g) {
+				return nil, errShort
+			}
+			n := int(g[o])
+			o++
+			for i := 0; i < n && len(flags) < numPoints; i++ {
+				flags = append(flags, fl)
+			}
+		}
+	}
+
+	readCoords := func(short, sameOrPositive byte) ([]int, error) {
+		cs := make([]int, numPoints)
+		v := 0
+		for i, fl := range flags {
+			switch {
+			case fl&short != 0:
+				if o >= len(g) {
+					return nil, errShort
+				}
+				d := int(g[o])
+				o++
+				if fl&sameOrPositive == 0 {
+					d = -d
+				}
+				v += d
+			case fl&sameOrPositive == 0:
+				if o+2 > len(g) {
+					return nil, errShort
+				}
+				v += i16(g, o)
+				o += 2
+			}
+			cs[i] = v
+		}
+		return cs, nil
+	}
+	xs, err := readCoords(xShort, xSameOrPositive)
+	if err != nil {
+		return nil, err
+	}
+	ys, err := readCoords(yShort, ySameOrPositive)
+	if err != nil {
+		return nil, err
+	}
+
+	var cs []Contour
+	start := 0
+	for _, end := range endPts {
+		if end < start || end >= numPoints {
+			return nil, errors.New("simple glyph: invalid contour end")
+		}
+		var c Contour
+		for i := start; i <= end; i++ {
+			c = append(c, Point{X: float32(xs[i]), Y: float32(ys[i]), OnCurve: flags[i]&onCurve != 0})
+		}
+		cs = append(cs, c)
+		start = end + 1
+	}
+	return cs, nil
+}
+
+func (f *Font) parseCompositeGlyph(g []byte, depth int, components *int) ([]Contour, error) {
+	errShort := errors.New("composite glyph: too short")
+	const (
+		arg1And2AreWords = 1 << 0
+		argsAreXYValues  = 1 << 1
+		weHaveAScale     = 1 << 3
+		moreComponents   = 1 << 5
+		xAndYScale       = 1 << 6
+		twoByTwo         = 1 << 7
+	)
+	var cs []Contour
+	o := 10
+	for {
+		if o+4 > len(g) {
+			return nil, errShort
+		}
+		if *components++; *components > maxCompositeComponents {
+			return nil, errors.New("composite glyph: too many components")
+		}
+		flags := u16(g, o)
+		gid := uint16(u16(g, o+2))
+		o += 4
+		var dx, dy int
+		if flags&arg1And2AreWords != 0 {
+			if o+4 > len(g) {
+				return nil, errShort
+			}
+			dx, dy = i16(g, o), i16(g, o+2)
+			o += 4
+		} else {
+			if o+2 > len(g) {
+				return nil, errShort
+			}
+			dx, dy = int(int8(g[o])), int(int8(g[o+1]))
+			o += 2
+		}
+		if flags&argsAreXYValues == 0 {
+			// point matching, rare, ignore offset
+			dx, dy = 0, 0
+		}
+		f2dot14 := func(o int) float32 { return float32(i16(g, o)) / (1 << 14) }
+		a, b, c, d := float32(1), float32(0), float32(0), float32(1)
+		switch {
+		case flags&weHaveAScale != 0:
+			if o+2 > len(g) {
+				return nil, errShort
+			}
+			a = f2dot14(o)
+			d = a
+			o += 2
+		case flags&xAndYScale != 0:
+			if o+4 > len(g) {
+				return nil, errShort
+			}
+			a, d = f2dot14(o), f2dot14(o+2)
+			o += 4
+		case flags&twoByTwo != 0:
+			if o+8 > len(g) {
+				return nil, errShort
+			}
+			a, b, c, d = f2dot14(o), f2dot14(o+2), f2dot14(o+4), f2dot14(o+6)
+			o += 8
+		}
+
+		ccs, err := f.contours(gid, depth+1, components)
+		if err != nil {
+			return nil, err
+		}
+		for _, cc := range ccs {
+			tc := make(Contour, len(cc))
+			for i, p := range cc {
+				tc[i] = Point{
+					X:       a*p.X + c*p.Y + float32(dx),
+					Y:       b*p.X + d*p.Y + float32(dy),
+					OnCurve: p.OnCurve,
+				}
+			}
+			cs = append(cs, tc)
+		}
+
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+	return cs, nil
+}
+
+// Segments calls moveTo, lineTo, quadTo and close for contours, resolving implicit on curve points
+func Segments(cs []Contour, moveTo, lineTo func(x, y float32), quadTo func(cx, cy, x, y float32), close func()) {
+	mid := func(a, b Point) Point { return Point{X: (a.X + b.X) / 2, Y: (a.Y + b.Y) / 2, OnCurve: true} }
+	for _, c := range cs {
+		if len(c) == 0 {
+			continue
+		}
+		// find a start point that is on curve, or use implicit midpoint
+		start := c[0]
+		first := 1
+		if !start.OnCurve {
+			last := c[len(c)-1]
+			if last.OnCurve {
+				start = last
+				first = 0
+				c = c[:len(c)-1]
+			} else {
+				start = mid(last, start)
+				first = 0
+			}
+		}
+		moveTo(start.X, start.Y)
+		var ctrl *Point
+		for i := first; i <= len(c); i++ {
+			p := start
+			if i < len(c) {
+				p = c[i]
+			}
+			switch {
+			case p.OnCurve && ctrl == nil:
+				lineTo(p.X, p.Y)
+			case p.OnCurve:
+				quadTo(ctrl.X, ctrl.Y, p.X, p.Y)
+				ctrl = nil
+			case ctrl == nil:
+				pc := p
+				ctrl = &pc
+			default:
+				m := mid(*ctrl, p)
+				quadTo(ctrl.X, ctrl.Y, m.X, m.Y)
+				pc := p
+				ctrl = &pc
+			}
+		}
+		close()
+	}
+}
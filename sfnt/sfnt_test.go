@@ -0,0 +1,235 @@
+package sfnt
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func be16(b []byte, vs ...int) []byte {
+	for _, v := range vs {
+		b = append(b, byte(v>>8), byte(v))
+	}
+	return b
+}
+
+func be32(b []byte, vs ...int) []byte {
+	for _, v := range vs {
+		b = append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	return b
+}
+
+// buildFont returns a font file with tables
+func buildFont(tables map[string][]byte) []byte {
+	var tags []string
+	for t := range tables {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+
+	b := be32(nil, 0x00010000)
+	b = be16(b, len(tags), 0, 0, 0)
+	offset := 12 + len(tags)*16
+	var data []byte
+	for _, t := range tags {
+		b = append(b, t...)
+		b = be32(b, 0, offset+len(data), len(tables[t]))
+		data = append(data, tables[t]...)
+	}
+	return append(b, data...)
+}
+
+// cmap12 returns a cmap table with a format 12 subtable with groups of start, end and glyph index
+func cmap12(groups ...[3]int) []byte {
+	b := be16(nil, 0, 1)
+	b = be16(b, 3, 10)
+	b = be32(b, 12)
+	b = be16(b, 12, 0)
+	b = be32(b, 16+len(groups)*12, 0, len(groups))
+	for _, g := range groups {
+		b = be32(b, g[0], g[1], g[2])
+	}
+	return b
+}
+
+// simpleGlyph returns a triangle glyph with points (0,0), (100,0) and (0,100)
+func simpleGlyph() []byte {
+	b := be16(nil, 1, 0, 0, 100, 100)
+	b = be16(b, 2, 0)
+	b = append(b, 1, 1, 1)
+	b = be16(b, 0, 100, -100)
+	return be16(b, 0, 0, 100)
+}
+
+// compositeGlyph returns a composite glyph with components of glyph index and x offset
+func compositeGlyph(components ...[2]int) []byte {
+	const (
+		arg1And2AreWords = 1 << 0
+		argsAreXYValues  = 1 << 1
+		moreComponents   = 1 << 5
+	)
+	b := be16(nil, -1, 0, 0, 100, 100)
+	for i, c := range components {
+		flags := arg1And2AreWords | argsAreXYValues
+		if i < len(components)-1 {
+			flags |= moreComponents
+		}
+		b = be16(b, flags, c[0], c[1], 0)
+	}
+	return b
+}
+
+// testFont returns tables of a font with glyphs, glyph 0 is empty
+func testFont(cmap []byte, glyphs ...[]byte) map[string][]byte {
+	head := make([]byte, 54)
+	be16(head[18:18], 1000)
+	be16(head[50:50], 1) // long loca offsets
+	hhea := make([]byte, 36)
+	be16(hhea[34:34], 1)
+
+	var glyf []byte
+	loca := be32(nil, 0, 0)
+	for _, g := range glyphs {
+		glyf = append(glyf, g...)
+		loca = be32(loca, len(glyf))
+	}
+
+	return map[string][]byte{
+		"head": head,
+		"hhea": hhea,
+		"hmtx": be16(nil, 600, 0),
+		"maxp": be16(nil, 0, 0x5000, len(glyphs)+1),
+		"cmap": cmap,
+		"glyf": glyf,
+		"loca": loca,
+	}
+}
+
+func TestParse(t *testing.T) {
+	f, err := Parse(buildFont(testFont(cmap12([3]int{'A', 'C', 1}, [3]int{0x1f600, 0x1f600, 2}), simpleGlyph(), simpleGlyph())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for r, expected := range map[rune]uint16{'A': 1, 'B': 2, 'C': 3, 'D': 0, 0x1f600: 2} {
+		if actual := f.GlyphIndex(r); actual != expected {
+			t.Errorf("%q: expected glyph %d, got %d", r, expected, actual)
+		}
+	}
+	if f.UnitsPerEm != 1000 || f.NumGlyphs != 3 || f.Advance(2) != 600 {
+		t.Errorf("unexpected font %+v", f)
+	}
+}
+
+func TestParseTruncated(t *testing.T) {
+	b := buildFont(testFont(cmap12([3]int{'A', 'A', 1}), simpleGlyph()))
+	for i := 0; i < len(b); i++ {
+		if _, err := Parse(b[0:i]); err == nil {
+			t.Errorf("%d: expected error for truncated font", i)
+		}
+	}
+
+	for _, tc := range []struct {
+		table string
+		err   string
+	}{
+		{"head", "head: table too short"},
+		{"hhea", "hhea: table too short"},
+		{"maxp", "maxp: table too short"},
+		{"hmtx", "hmtx: table too short"},
+		{"cmap", "cmap: table too short"},
+	} {
+		tables := testFont(cmap12([3]int{'A', 'A', 1}), simpleGlyph())
+		tables[tc.table] = tables[tc.table][0 : len(tables[tc.table])-1]
+		if tc.table == "cmap" {
+			tables[tc.table] = tables[tc.table][0:3]
+		}
+		if _, err := Parse(buildFont(tables)); err == nil || err.Error() != tc.err {
+			t.Errorf("%s: expected error %q, got %v", tc.table, tc.err, err)
+		}
+	}
+}
+
+func TestParseCmap(t *testing.T) {
+	format12TooShort := cmap12([3]int{'A', 'B', 1})
+	format12TooShort = format12TooShort[0 : len(format12TooShort)-1]
+	noUnicode := cmap12([3]int{'A', 'B', 1})
+	be16(noUnicode[4:4], 1) // macintosh platform
+	format4TooShort := be16(nil, 0, 1, 3, 1)
+	format4TooShort = be32(format4TooShort, 12)
+	format4TooShort = be16(format4TooShort, 4, 0, 0, 0, 100)
+
+	for _, tc := range []struct {
+		name string
+		cmap []byte
+		err  string
+	}{
+		{"format 12 too short", format12TooShort, "format 12: too short"},
+		{"format 12 end before start", cmap12([3]int{'B', 'A', 1}), "format 12: invalid group 0x42-0x41"},
+		{"format 12 end out of range", cmap12([3]int{0, 0x110000, 1}), "format 12: invalid group 0x0-0x110000"},
+		{"format 12 too many runes", cmap12([3]int{0, 0xfffff, 1}), "too many mapped runes"},
+		{"format 12 too many runes total", cmap12([3]int{0, 0xffff, 1}, [3]int{0, 0xffff, 1}, [3]int{0, 0xffff, 1}, [3]int{0, 0xffff, 1}, [3]int{0, 0xffff, 1}), "too many mapped runes"},
+		{"format 4 too short", format4TooShort, "format 4: too short"},
+		{"no unicode subtable", noUnicode, "no supported unicode subtable"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(buildFont(testFont(tc.cmap, simpleGlyph())))
+			if err == nil || err.Error() != "cmap: "+tc.err {
+				t.Errorf("expected error %q, got %v", "cmap: "+tc.err, err)
+			}
+		})
+	}
+}
+
+func TestContours(t *testing.T) {
+	manyComponents := func(gid int) [][2]int {
+		cs := make([][2]int, 200)
+		for i := range cs {
+			cs[i] = [2]int{gid, 0}
+		}
+		return cs
+	}
+	truncatedSimple := simpleGlyph()
+	truncatedSimple = truncatedSimple[0 : len(truncatedSimple)-1]
+	truncatedComposite := compositeGlyph([2]int{1, 0})
+	truncatedComposite = truncatedComposite[0 : len(truncatedComposite)-1]
+
+	f, err := Parse(buildFont(testFont(cmap12([3]int{'A', 'A', 1}),
+		simpleGlyph(), // 1
+		compositeGlyph([2]int{1, 0}, [2]int{1, 200}), // 2
+		compositeGlyph(manyComponents(8)...),         // 3, 200*200 components
+		compositeGlyph([2]int{4, 0}),                 // 4, references itself
+		truncatedSimple,                              // 5
+		truncatedComposite,                           // 6
+		compositeGlyph([2]int{100, 0}),               // 7, out of range component
+		compositeGlyph(manyComponents(1)...),         // 8
+	)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cs, err := f.Contours(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 2 || len(cs[1]) != 3 || cs[1][1] != (Point{X: 300, Y: 0, OnCurve: true}) {
+		t.Errorf("unexpected contours %v", cs)
+	}
+
+	for _, tc := range []struct {
+		gid uint16
+		err string
+	}{
+		{3, "composite glyph: too many components"},
+		{4, "composite glyph too deep"},
+		{5, "simple glyph: too short"},
+		{6, "composite glyph: too short"},
+		{7, "glyph 100: out of range"},
+		{9, "glyph 9: out of range"},
+	} {
+		if _, err := f.Contours(tc.gid); err == nil || !strings.HasPrefix(err.Error(), tc.err) {
+			t.Errorf("glyph %d: expected error %q, got %v", tc.gid, tc.err, err)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package sfnt
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+// tables copied as is to a subset, hinting tables are kept so rendering does
+// not change. Layout tables like GSUB and GPOS are dropped as they refer to
+// glyph indexes.
+var subsetCopyTables = []string{"OS/2", "cvt ", "fpgm", "gasp", "name", "prep"}
+
+// Subset returns a TrueType font with only the glyphs needed for runes, glyph
+// .notdef is always included. Glyphs are renumbered, layout tables are dropped
+// and the new cmap only maps runes. CFF fonts are not supported.
+func (f *Font) Subset(runes []rune) ([]byte, error) {
+	if f.CFF {
+		return nil, ErrCFFNotSupported
+	}
+	if _, ok := f.tables["glyf"]; !ok {
+		return nil, errors.New("glyf: missing table")
+	}
+
+	// old glyph indexes to keep, composite glyph components are added while
+	// walking the list
+	keep := map[uint16]bool{0: true}
+	var queue []uint16
+	add := func(gid uint16) {
+		if !keep[gid] && int(gid) < f.NumGlyphs {
+			keep[gid] = true
+			queue = append(queue, gid)
+		}
+	}
+	queue = append(queue, 0)
+	runeGIDs := map[rune]uint16{}
+	for _, r := range runes {
+		if gid := f.GlyphIndex(r); gid != 0 {
+			runeGIDs[r] = gid
+			add(gid)
+		}
+	}
+	for len(queue) > 0 {
+		gid := queue[0]
+		queue = queue[1:]
+		g, err := f.glyphData(gid)
+		if err != nil {
+			return nil, err
+		}
+		if err := compositeComponents(g, func(o int) { add(uint16(u16(g, o))) }); err != nil {
+			return nil, err
+		}
+	}
+	var oldGIDs []uint16
+	for gid := range keep {
+		oldGIDs = append(oldGIDs, gid)
+	}
+	sort.Slice(oldGIDs, func(i, j int) bool { return oldGIDs[i] < oldGIDs[j] })
+	newGIDs := map[uint16]uint16{}
+	for i, gid := range oldGIDs {
+		newGIDs[gid] = uint16(i)
+	}
+
+	// glyf and loca with long offsets, glyphs are padded to 4 bytes
+	var glyf []byte
+	loca := make([]byte, 0, (len(oldGIDs)+1)*4)
+	hmtx := make([]byte, 0, len(oldGIDs)*4)
+	for _, gid := range oldGIDs {
+		loca = appendU32(loca, uint32(len(glyf)))
+		g, err := f.glyphData(gid)
+		if err != nil {
+			return nil, err
+		}
+		start := len(glyf)
+		glyf = append(glyf, g...)
+		ng := glyf[start:]
+		if err := compositeComponents(ng, func(o int) {
+			binary.BigEndian.PutUint16(ng[o:], newGIDs[uint16(u16(ng, o))])
+		}); err != nil {
+			return nil, err
+		}
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+
+		hmtx = appendU16(hmtx, uint16(f.Advance(gid)))
+		hmtx = appendU16(hmtx, uint16(f.leftSideBearing(gid)))
+	}
+	loca = appendU32(loca, uint32(len(glyf)))
+
+	head := append([]byte{}, f.tables["head"]...)
+	// checksum adjustment is set when font is written
+	binary.BigEndian.PutUint32(head[8:], 0)
+	binary.BigEndian.PutUint16(head[50:], 1)
+	hhea := append([]byte{}, f.tables["hhea"]...)
+	binary.BigEndian.PutUint16(hhea[34:], uint16(len(oldGIDs)))
+	maxp := append([]byte{}, f.tables["maxp"]...)
+	binary.BigEndian.PutUint16(maxp[4:], uint16(len(oldGIDs)))
+
+	newRuneGIDs := map[rune]uint16{}
+	for r, gid := range runeGIDs {
+		newRuneGIDs[r] = newGIDs[gid]
+	}
+
+	tables := map[string][]byte{
+		"head": head,
+		"hhea": hhea,
+		"maxp": maxp,
+		"hmtx": hmtx,
+		"loca": loca,
+		"glyf": glyf,
+		"cmap": buildCmap(newRuneGIDs),
+	}
+	if post := f.tables["post"]; len(post) >= 32 {
+		// format 3 has no glyph names
+		p := append([]byte{}, post[0:32]...)
+		binary.BigEndian.PutUint32(p[0:], 0x00030000)
+		tables["post"] = p
+	}
+	for _, t := range subsetCopyTables {
+		if b, ok := f.tables[t]; ok {
+			tables[t] = b
+		}
+	}
+
+	return writeFont(tables), nil
+}
+
+// leftSideBearing returns left side bearing of glyph from hmtx
+func (f *Font) leftSideBearing(gid uint16) int {
+	hmtx := f.tables["hmtx"]
+	i := int(gid)
+	if i < f.numHMetrics {
+		return i16(hmtx, i*4+2)
+	}
+	o := f.numHMetrics*4 + (i-f.numHMetrics)*2
+	if o+2 > len(hmtx) {
+		return 0
+	}
+	return i16(hmtx, o)
+}
+
+// compositeComponents calls fn with offset of glyph index of each component
+// if g is a composite glyph
+func compositeComponents(g []byte, fn func(o int)) error {
+	if len(g) < 10 || i16(g, 0) >= 0 {
+		return nil
+	}
+	const (
+		arg1And2AreWords = 1 << 0
+		weHaveAScale     = 1 << 3
+		moreComponents   = 1 << 5
+		xAndYScale       = 1 << 6
+		twoByTwo         = 1 << 7
+	)
+	o := 10
+	for {
+		if o+4 > len(g) {
+			return errors.New("composite glyph: too short")
+		}
+		flags := u16(g, o)
+		fn(o + 2)
+		o += 4
+		if flags&arg1And2AreWords != 0 {
+			o += 4
+		} else {
+			o += 2
+		}
+		switch {
+		case flags&weHaveAScale != 0:
+			o += 2
+		case flags&xAndYScale != 0:
+			o += 4
+		case flags&twoByTwo != 0:
+			o += 8
+		}
+		if flags&moreComponents == 0 {
+			return nil
+		}
+	}
+}
+
+// buildCmap builds a cmap with a format 4 subtable for BMP runes and a format
+// 12 subtable if there are runes outside BMP
+func buildCmap(runeGIDs map[rune]uint16) []byte {
+	var runes []rune
+	for r := range runeGIDs {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	// ranges of consecutive runes with consecutive glyph indexes
+	type group struct {
+		start, end rune
+		gid        uint16
+	}
+	var groups []group
+	for _, r := range runes {
+		gid := runeGIDs[r]
+		if n := len(groups); n > 0 {
+			g := &groups[n-1]
+			if r == g.end+1 && gid == g.gid+uint16(r-g.start) {
+				g.end = r
+				continue
+			}
+		}
+		groups = append(groups, group{start: r, end: r, gid: gid})
+	}
+
+	var bmpGroups []group
+	for _, g := range groups {
+		if g.start > 0xfffe {
+			break
+		}
+		if g.end > 0xfffe {
+			g.end = 0xfffe
+		}
+		bmpGroups = append(bmpGroups, g)
+	}
+	// format 4 requires a last 0xffff segment
+	bmpGroups = append(bmpGroups, group{start: 0xffff, end: 0xffff, gid: 0})
+
+	segX2 := len(bmpGroups) * 2
+	searchRange := 2
+	entrySelector := 0
+	for searchRange*2 <= segX2 {
+		searchRange *= 2
+		entrySelector++
+	}
+	var f4 []byte
+	f4 = appendU16(f4, 4)
+	f4 = appendU16(f4, uint16(16+segX2*4))
+	f4 = appendU16(f4, 0)
+	f4 = appendU16(f4, uint16(segX2))
+	f4 = appendU16(f4, uint16(searchRange))
+	f4 = appendU16(f4, uint16(entrySelector))
+	f4 = appendU16(f4, uint16(segX2-searchRange))
+	for _, g := range bmpGroups {
+		f4 = appendU16(f4, uint16(g.end))
+	}
+	f4 = appendU16(f4, 0)
+	for _, g := range bmpGroups {
+		f4 = appendU16(f4, uint16(g.start))
+	}
+	for _, g := range bmpGroups {
+		delta := uint16(1)
+		if g.start != 0xffff {
+			delta = g.gid - uint16(g.start)
+		}
+		f4 = appendU16(f4, delta)
+	}
+	for range bmpGroups {
+		f4 = appendU16(f4, 0)
+	}
+
+	subtables := [][]byte{f4}
+	encodings := [][2]uint16{{3, 1}}
+	if len(runes) > 0 && runes[len(runes)-1] > 0xffff {
+		var f12 []byte
+		f12 = appendU16(f12, 12)
+		f12 = appendU16(f12, 0)
+		f12 = appendU32(f12, uint32(16+len(groups)*12))
+		f12 = appendU32(f12, 0)
+		f12 = appendU32(f12, uint32(len(groups)))
+		for _, g := range groups {
+			f12 = appendU32(f12, uint32(g.start))
+			f12 = appendU32(f12, uint32(g.end))
+			f12 = appendU32(f12, uint32(g.gid))
+		}
+		subtables = append(subtables, f12)
+		encodings = append(encodings, [2]uint16{3, 10})
+	}
+
+	var b []byte
+	b = appendU16(b, 0)
+	b = appendU16(b, uint16(len(subtables)))
+	offset := 4 + len(subtables)*8
+	for i, st := range subtables {
+		b = appendU16(b, encodings[i][0])
+		b = appendU16(b, encodings[i][1])
+		b = appendU32(b, uint32(offset))
+		offset += len(st)
+	}
+	for _, st := range subtables {
+		b = append(b, st...)
+	}
+	return b
+}
+
+func appendU16(b []byte, v uint16) []byte { return append(b, byte(v>>8), byte(v)) }
+func appendU32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func checksum(b []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(b); i += 4 {
+		var w [4]byte
+		copy(w[:], b[i:])
+		sum += binary.BigEndian.Uint32(w[:])
+	}
+	return sum
+}
+
+// writeFont writes a TrueType font file with tables sorted by tag
+func writeFont(tables map[string][]byte) []byte {
+	var tags []string
+	for t := range tables {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+
+	searchRange := 1
+	entrySelector := 0
+	for searchRange*2 <= len(tags) {
+		searchRange *= 2
+		entrySelector++
+	}
+	searchRange *= 16
+
+	var b []byte
+	b = appendU32(b, 0x00010000)
+	b = appendU16(b, uint16(len(tags)))
+	b = appendU16(b, uint16(searchRange))
+	b = appendU16(b, uint16(entrySelector))
+	b = appendU16(b, uint16(len(tags)*16-searchRange))
+	offset := 12 + len(tags)*16
+	headOffset := 0
+	for _, t := range tags {
+		tb := tables[t]
+		if t == "head" {
+			headOffset = offset
+		}
+		b = append(b, t...)
+		b = appendU32(b, checksum(tb))
+		b = appendU32(b, uint32(offset))
+		b = appendU32(b, uint32(len(tb)))
+		offset += (len(tb) + 3) &^ 3
+	}
+	for _, t := range tags {
+		b = append(b, tables[t]...)
+		for len(b)%4 != 0 {
+			b = append(b, 0)
+		}
+	}
+	binary.BigEndian.PutUint32(b[headOffset+8:], 0xb1b0afba-checksum(b))
+	return b
+}
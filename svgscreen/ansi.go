@@ -0,0 +1,238 @@
+package svgscreen
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/wader/ansisvg/ansidecoder"
+	"github.com/wader/ansisvg/color"
+)
+
+// Color modes for RenderANSI
+const (
+	// ColorModeTrueColor keeps colors as is
+	ColorModeTrueColor = "truecolor"
+	// ColorMode256 maps RGB colors to the nearest color in the 256 color cube or grayscale ramp
+	ColorMode256 = "256"
+	// ColorMode16 maps RGB colors to the nearest of the 16 colors using standard xterm values
+	ColorMode16 = "16"
+	// ColorModePalette maps RGB colors to the nearest of the 16 colors using the color scheme
+	ColorModePalette = "palette"
+)
+
+// ANSIOptions are options for RenderANSI
+type ANSIOptions struct {
+	// ColorMode is one of ColorModeTrueColor (default), ColorMode256, ColorMode16 or ColorModePalette
+	ColorMode string
+}
+
+// xterm default values for the 16 colors
+var xterm16Colors = [16]string{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// ansiColorMapper maps RGB colors to palette indexes with a cache as screens
+// usually have few unique colors
+type ansiColorMapper struct {
+	// indexes in palette corresponds to index of color, offset by first
+	palette []color.Color
+	first   int
+	cache   map[string]int
+}
+
+func newANSIColorMapper(hexes []string, first int) *ansiColorMapper {
+	m := &ansiColorMapper{first: first, cache: map[string]int{}}
+	for _, h := range hexes {
+		m.palette = append(m.palette, color.NewFromHex(h))
+	}
+	return m
+}
+
+func (m *ansiColorMapper) index(hex string) int {
+	if n, ok := m.cache[hex]; ok {
+		return n
+	}
+	n := m.first + color.NewFromHex(hex).Nearest(m.palette)
+	m.cache[hex] = n
+	return n
+}
+
+// sgrState is the SGR relevant state of a char, colors are SGR parameters
+// so colors mapped to the same code are equal
+type sgrState struct {
+	fg            string
+	bg            string
+	bold          bool
+	dim           bool
+	italic        bool
+	underline     bool
+	invert        bool
+	strikethrough bool
+}
+
+func (st sgrState) isDefault() bool {
+	return st == sgrState{}
+}
+
+// codes returns SGR parameters to go from state from to st
+func (st sgrState) codes(from sgrState) []string {
+	var cs []string
+	// bold and dim are both turned off by 22
+	if (from.bold && !st.bold) || (from.dim && !st.dim) {
+		cs = append(cs, "22")
+		from.bold, from.dim = false, false
+	}
+	for _, a := range []struct {
+		from, to bool
+		on, off  string
+	}{
+		{from.bold, st.bold, "1", ""},
+		{from.dim, st.dim, "2", ""},
+		{from.italic, st.italic, "3", "23"},
+		{from.underline, st.underline, "4", "24"},
+		{from.invert, st.invert, "7", "27"},
+		{from.strikethrough, st.strikethrough, "9", "29"},
+	} {
+		switch {
+		case !a.from && a.to:
+			cs = append(cs, a.on)
+		case a.from && !a.to:
+			cs = append(cs, a.off)
+		}
+	}
+	if from.fg != st.fg {
+		cs = append(cs, st.fg)
+		if st.fg == "" {
+			cs[len(cs)-1] = "39"
+		}
+	}
+	if from.bg != st.bg {
+		cs = append(cs, st.bg)
+		if st.bg == "" {
+			cs[len(cs)-1] = "49"
+		}
+	}
+	return cs
+}
+
+// sgr returns shortest SGR sequence to go from state from to st, either
+// changes only or a reset followed by st
+func (st sgrState) sgr(from sgrState) string {
+	if st == from {
+		return ""
+	}
+	changes := strings.Join(st.codes(from), ";")
+	reset := strings.Join(append([]string{"0"}, st.codes(sgrState{})...), ";")
+	if len(reset) < len(changes) {
+		changes = reset
+	}
+	return "\x1b[" + changes + "m"
+}
+
+// isBlank returns true if char is not visible when at end of line
+func (c Char) isBlank() bool {
+	return c.Rune == ' ' && c.Background == ColorDefault && c.Attr&(AttrInvert|AttrUnderline|AttrStrikethrough) == 0
+}
+
+// RenderText writes screen as plain text without any styling
+func (s *Screen) RenderText(w io.Writer) error {
+	t := PlainText(s.Lines)
+	if t != "" {
+		t += "\n"
+	}
+	_, err := io.WriteString(w, t)
+	return err
+}
+
+// RenderANSI writes screen as text with minimal SGR sequences and no cursor movements.
+// Each line ends with reset if needed so lines can be used independently.
+func (s *Screen) RenderANSI(w io.Writer, opts ANSIOptions) error {
+	var mapper *ansiColorMapper
+	switch opts.ColorMode {
+	case "", ColorModeTrueColor:
+	case ColorMode256:
+		var hexes []string
+		for n := 16; n <= 255; n++ {
+			r, g, b, _ := ansidecoder.Color256(n).RGB()
+			hexes = append(hexes, RGBColor(r, g, b).Hex())
+		}
+		mapper = newANSIColorMapper(hexes, 16)
+	case ColorMode16:
+		mapper = newANSIColorMapper(xterm16Colors[:], 0)
+	case ColorModePalette:
+		mapper = newANSIColorMapper(s.ANSIColors[:], 0)
+	default:
+		return fmt.Errorf("%s: unsupported color mode", opts.ColorMode)
+	}
+
+	colorCodes := func(c Color, bg bool) string {
+		base, brightBase, extended := 30, 90, "38"
+		if bg {
+			base, brightBase, extended = 40, 100, "48"
+		}
+		if c == ColorDefault {
+			return ""
+		}
+		n := -1
+		if idx, ok := c.ANSI(); ok {
+			n = idx
+		} else if mapper != nil {
+			n = mapper.index(c.Hex())
+		}
+		switch {
+		case n == -1:
+			r, g, b, _ := c.RGB()
+			return fmt.Sprintf("%s;2;%d;%d;%d", extended, r, g, b)
+		case n < 8:
+			return strconv.Itoa(base + n)
+		case n < 16:
+			return strconv.Itoa(brightBase + n - 8)
+		default:
+			return extended + ";5;" + strconv.Itoa(n)
+		}
+	}
+
+	var rows []string
+	for _, l := range s.Lines {
+		for len(rows) <= l.Y {
+			rows = append(rows, "")
+		}
+		chars := l.Chars
+		for len(chars) > 0 && chars[len(chars)-1].isBlank() {
+			chars = chars[:len(chars)-1]
+		}
+
+		var sb strings.Builder
+		var current sgrState
+		for _, c := range chars {
+			st := sgrState{
+				fg:            colorCodes(c.Foreground, false),
+				bg:            colorCodes(c.Background, true),
+				bold:          c.Attr.Has(AttrIntensity),
+				dim:           c.Attr.Has(AttrDim),
+				italic:        c.Attr.Has(AttrItalic),
+				underline:     c.Attr.Has(AttrUnderline),
+				invert:        c.Attr.Has(AttrInvert),
+				strikethrough: c.Attr.Has(AttrStrikethrough),
+			}
+			sb.WriteString(st.sgr(current))
+			sb.WriteRune(c.Rune)
+			current = st
+		}
+		if !current.isDefault() {
+			sb.WriteString("\x1b[0m")
+		}
+		rows[l.Y] = sb.String()
+	}
+	for len(rows) > 0 && rows[len(rows)-1] == "" {
+		rows = rows[:len(rows)-1]
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	_, err := io.WriteString(w, strings.Join(rows, "\n")+"\n")
+	return err
+}
@@ -0,0 +1,433 @@
+package svgscreen
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// boxArms are up, right, down and left arm weights of box drawing chars
+// U+2500-U+257F, 0 none, 1 light, 2 heavy and 3 double. Empty are dashed,
+// rounded and diagonal lines drawn separately.
+var boxArms = [128]string{
+	"0101", "0202", "1010", "2020", "", "", "", "", "", "", "", "", "0110", "0210", "0120", "0220",
+	"0011", "0012", "0021", "0022", "1100", "1200", "2100", "2200", "1001", "1002", "2001", "2002", "1110", "1210", "2110", "1120",
+	"2120", "2210", "1220", "2220", "1011", "1012", "2011", "1021", "2021", "2012", "1022", "2022", "0111", "0112", "0211", "0212",
+	"0121", "0122", "0221", "0222", "1101", "1102", "1201", "1202", "2101", "2102", "2201", "2202", "1111", "1112", "1211", "1212",
+	"2111", "1121", "2121", "2112", "2211", "1122", "1221", "2212", "1222", "2122", "2221", "2222", "", "", "", "",
+	"0303", "3030", "0310", "0130", "0330", "0013", "0031", "0033", "1300", "3100", "3300", "1003", "3001", "3003", "1310", "3130",
+	"3330", "1013", "3031", "3033", "0313", "0131", "0333", "1303", "3101", "3303", "1313", "3131", "3333", "", "", "",
+	"", "", "", "", "0001", "1000", "0100", "0010", "0002", "2000", "0200", "0020", "0201", "1020", "0102", "2010",
+}
+
+// boxDashes are dashed lines as number of dashes and weight, horizontal if
+// rune is even
+var boxDashes = map[rune][2]int{
+	0x2504: {3, 1}, 0x2505: {3, 2}, 0x2506: {3, 1}, 0x2507: {3, 2},
+	0x2508: {4, 1}, 0x2509: {4, 2}, 0x250a: {4, 1}, 0x250b: {4, 2},
+	0x254c: {2, 1}, 0x254d: {2, 2}, 0x254e: {2, 1}, 0x254f: {2, 2},
+}
+
+// boxPath is a filled path of a box drawing symbol, Opacity is used for shades
+type boxPath struct {
+	D       string
+	Opacity string
+}
+
+// boxSymbol is a box drawing, block element or Powerline glyph drawn to fill
+// a cell of ViewBox size exactly
+type boxSymbol struct {
+	ID      string
+	ViewBox string
+	Paths   []boxPath
+}
+
+// boxBuilder builds paths in a w by h cell with line thickness t. Points are
+// mirrored horizontally and vertically if mx or my is set, around center of
+// the cell or around center of lines if aroundLines is set.
+type boxBuilder struct {
+	w, h, t     float64
+	mx, my      bool
+	aroundLines bool
+	sb          strings.Builder
+}
+
+func (b *boxBuilder) point(x, y float64) string {
+	w, h := b.w, b.h
+	if b.aroundLines {
+		cx, cy := b.center()
+		w, h = cx*2, cy*2
+	}
+	if b.mx {
+		x = w - x
+	}
+	if b.my {
+		y = h - y
+	}
+	return pathNumber(x) + " " + pathNumber(y)
+}
+
+// poly adds a polygon from x, y pairs
+func (b *boxBuilder) poly(xys ...float64) {
+	for i := 0; i+1 < len(xys); i += 2 {
+		if i == 0 {
+			b.sb.WriteString("M")
+		} else {
+			b.sb.WriteString("L")
+		}
+		b.sb.WriteString(b.point(xys[i], xys[i+1]))
+	}
+	b.sb.WriteString("Z")
+}
+
+func (b *boxBuilder) rect(x0, y0, x1, y1 float64) {
+	b.poly(x0, y0, x1, y0, x1, y1, x0, y1)
+}
+
+// arc adds an elliptical arc to x, y, sweep is flipped if mirrored in one direction
+func (b *boxBuilder) arc(rx, ry float64, sweep bool, x, y float64) {
+	if b.mx != b.my {
+		sweep = !sweep
+	}
+	flag := "0"
+	if sweep {
+		flag = "1"
+	}
+	b.sb.WriteString("A" + pathNumber(rx) + " " + pathNumber(ry) + " 0 0 " + flag + " " + b.point(x, y))
+}
+
+// center returns center of cell adjusted so light lines are on whole pixels
+func (b *boxBuilder) center() (float64, float64) {
+	return math.Floor((b.w-b.t)/2) + b.t/2, math.Floor((b.h-b.t)/2) + b.t/2
+}
+
+func (b *boxBuilder) moveTo(x, y float64) { b.sb.WriteString("M" + b.point(x, y)) }
+func (b *boxBuilder) lineTo(x, y float64) { b.sb.WriteString("L" + b.point(x, y)) }
+func (b *boxBuilder) close()              { b.sb.WriteString("Z") }
+
+// arms draws lines from center to edges for arm weights up, right, down and left
+func (b *boxBuilder) arms(a [4]int) {
+	cx, cy := b.center()
+	t := b.t
+	d := t // double line offset from center
+	thick := func(weight int) float64 {
+		switch weight {
+		case 1:
+			return t
+		case 2:
+			return t * 2
+		}
+		return 0
+	}
+	// draw arm i, arm 0 is up and then clockwise. Lines are drawn in a
+	// rotated space where the arm goes to the right, c is center and n
+	// is the arm length.
+	for i, weight := range a {
+		if weight == 0 {
+			continue
+		}
+		horizontal := i == 1 || i == 3
+		dir := 1.0
+		if i == 0 || i == 3 {
+			dir = -1
+		}
+		// perpendicular arms before and after in the direction of the arm
+		// offset, for right arm they are up and down
+		var before, after, opposite int
+		c, n := cx, b.w
+		if horizontal {
+			before, after, opposite = a[0], a[2], a[(i+2)%4]
+		} else {
+			before, after, opposite = a[3], a[1], a[(i+2)%4]
+			c, n = cy, b.h
+		}
+		line := func(offset, start, width float64) {
+			end := n
+			if dir < 0 {
+				end = 0
+			}
+			if horizontal {
+				b.rect(start, cy+offset-width/2, end, cy+offset+width/2)
+			} else {
+				b.rect(cx+offset-width/2, start, cx+offset+width/2, end)
+			}
+		}
+		offsetOf := func(weight int) float64 {
+			if weight == 3 {
+				return d
+			}
+			return 0
+		}
+
+		if weight == 3 {
+			for _, o := range []float64{-d, d} {
+				near, far := before, after
+				if o > 0 {
+					near, far = after, before
+				}
+				start := c
+				switch {
+				case near != 0:
+					// stop at near perpendicular line on our side
+					start = c + dir*offsetOf(near) - dir*t/2
+				case far != 0:
+					// outer corner, extend to far perpendicular line
+					start = c - dir*(offsetOf(far)+t/2)
+				}
+				line(o, start, t)
+			}
+			continue
+		}
+
+		var start float64
+		switch {
+		case before == 3 || after == 3:
+			switch {
+			case opposite != 0:
+				start = c - dir*t/2
+			case before != 0 && after != 0:
+				start = c + dir*d - dir*t/2
+			default:
+				start = c - dir*(d+t/2)
+			}
+		default:
+			start = c - dir*math.Max(thick(before), thick(after))/2
+		}
+		line(0, start, thick(weight))
+	}
+}
+
+// dashes draws a dashed line with n dashes centered in equal segments
+func (b *boxBuilder) dashes(n int, t float64, horizontal bool) {
+	l := b.w
+	if !horizontal {
+		l = b.h
+	}
+	cx, cy := b.center()
+	seg := l / float64(n)
+	gap := seg / 3
+	for i := 0; i < n; i++ {
+		s := float64(i)*seg + gap/2
+		e := float64(i+1)*seg - gap/2
+		if horizontal {
+			b.rect(s, cy-t/2, e, cy+t/2)
+		} else {
+			b.rect(cx-t/2, s, cx+t/2, e)
+		}
+	}
+}
+
+// roundedCorner draws ╭, other corners are mirrors of it
+func (b *boxBuilder) roundedCorner() {
+	cx, cy := b.center()
+	t := b.t
+	r := math.Min(b.w-cx, b.h-cy)
+	b.moveTo(cx-t/2, b.h)
+	b.lineTo(cx-t/2, cy+r)
+	b.arc(r+t/2, r+t/2, true, cx+r, cy-t/2)
+	b.lineTo(b.w, cy-t/2)
+	b.lineTo(b.w, cy+t/2)
+	b.lineTo(cx+r, cy+t/2)
+	b.arc(r-t/2, r-t/2, false, cx+t/2, cy+r)
+	b.lineTo(cx+t/2, b.h)
+	b.close()
+}
+
+// diagonal draws a line from bottom left to top right, overflow is clipped
+// by the symbol viewport
+func (b *boxBuilder) diagonal(t float64) {
+	o := t / 2 * math.Hypot(b.w, b.h) / b.h
+	b.poly(b.w-o, 0, b.w+o, 0, o, b.h, -o, b.h)
+}
+
+// chevron draws powerline thin right arrow
+func (b *boxBuilder) chevron() {
+	t := b.t
+	oy := t * math.Hypot(b.w, b.h/2) / b.w
+	ox := t * math.Hypot(b.w, b.h/2) / (b.h / 2)
+	b.poly(0, 0, b.w, b.h/2, 0, b.h, 0, b.h-oy, b.w-ox, b.h/2, 0, oy)
+}
+
+// halfCircle draws powerline right half circle, thin as an outline
+func (b *boxBuilder) halfCircle(thin bool) {
+	b.moveTo(0, 0)
+	b.arc(b.w, b.h/2, true, 0, b.h)
+	if thin {
+		t := b.t
+		b.lineTo(0, b.h-t)
+		b.arc(b.w-t, b.h/2-t, false, 0, t)
+	}
+	b.close()
+}
+
+// blockQuadrants are upper left, upper right, lower left and lower right
+// quadrants of U+2596-U+259F
+var blockQuadrants = [10][4]bool{
+	{false, false, true, false},
+	{false, false, false, true},
+	{true, false, false, false},
+	{true, false, true, true},
+	{true, false, false, true},
+	{true, true, true, false},
+	{true, true, false, true},
+	{false, true, false, false},
+	{false, true, true, false},
+	{false, true, true, true},
+}
+
+// isBoxRune returns true if r is drawn by boxGlyph
+func isBoxRune(r rune) bool {
+	return (r >= 0x2500 && r <= 0x259f) || (r >= 0xe0b0 && r <= 0xe0bf)
+}
+
+// boxGlyph returns paths for box drawing U+2500-U+257F, block elements
+// U+2580-U+259F and Powerline U+E0B0-U+E0BF filling a w by h cell
+func boxGlyph(r rune, w, h float64) []boxPath {
+	t := math.Max(1, math.Round(w/8))
+	b := &boxBuilder{w: w, h: h, t: t}
+	var paths []boxPath
+	flush := func(opacity string) {
+		if b.sb.Len() > 0 {
+			paths = append(paths, boxPath{D: b.sb.String(), Opacity: opacity})
+			b.sb.Reset()
+		}
+	}
+	eighth := func(n int, v float64) float64 { return float64(n) * v / 8 }
+
+	switch {
+	case r >= 0x2500 && r <= 0x257f:
+		if a := boxArms[r-0x2500]; a != "" {
+			b.arms([4]int{int(a[0] - '0'), int(a[1] - '0'), int(a[2] - '0'), int(a[3] - '0')})
+			break
+		}
+		if d, ok := boxDashes[r]; ok {
+			b.dashes(d[0], t*float64(d[1]), r%2 == 0)
+			break
+		}
+		switch r {
+		case 0x256d, 0x256e, 0x256f, 0x2570:
+			b.mx = r == 0x256e || r == 0x256f
+			b.my = r == 0x256f || r == 0x2570
+			b.aroundLines = true
+			b.roundedCorner()
+		case 0x2571:
+			b.diagonal(t)
+		case 0x2572:
+			b.mx = true
+			b.diagonal(t)
+		case 0x2573:
+			b.diagonal(t)
+			b.mx = true
+			b.diagonal(t)
+		}
+	case r == 0x2580:
+		b.rect(0, 0, w, h/2)
+	case r >= 0x2581 && r <= 0x2588:
+		b.rect(0, h-eighth(int(r-0x2580), h), w, h)
+	case r >= 0x2589 && r <= 0x258f:
+		b.rect(0, 0, eighth(int(0x2590-r), w), h)
+	case r == 0x2590:
+		b.rect(w/2, 0, w, h)
+	case r >= 0x2591 && r <= 0x2593:
+		b.rect(0, 0, w, h)
+		flush([]string{"0.25", "0.5", "0.75"}[r-0x2591])
+	case r == 0x2594:
+		b.rect(0, 0, w, eighth(1, h))
+	case r == 0x2595:
+		b.rect(w-eighth(1, w), 0, w, h)
+	case r >= 0x2596 && r <= 0x259f:
+		for i, on := range blockQuadrants[r-0x2596] {
+			if on {
+				x, y := float64(i%2)*w/2, float64(i/2)*h/2
+				b.rect(x, y, x+w/2, y+h/2)
+			}
+		}
+	case r >= 0xe0b0 && r <= 0xe0b7:
+		// right pointing and mirrored left pointing
+		b.mx = r&2 != 0
+		switch r &^ 2 {
+		case 0xe0b0:
+			b.poly(0, 0, w, h/2, 0, h)
+		case 0xe0b1:
+			b.chevron()
+		case 0xe0b4:
+			b.halfCircle(false)
+		case 0xe0b5:
+			b.halfCircle(true)
+		}
+	case r >= 0xe0b8 && r <= 0xe0bf:
+		// lower left, lower right, upper left and upper right triangles
+		// each followed by the diagonal of its hypotenuse
+		i := (r - 0xe0b8) / 2
+		if r%2 == 0 {
+			b.mx = i == 1 || i == 3
+			b.my = i >= 2
+			b.poly(0, 0, w, h, 0, h)
+		} else {
+			// backslash for lower left and upper right
+			b.mx = i == 0 || i == 3
+			b.diagonal(t)
+		}
+	default:
+		return nil
+	}
+	flush("")
+	return paths
+}
+
+// boxChar returns rune of c and true if c is drawn as a box drawing symbol
+func (s *Screen) boxChar(c Char) (rune, bool) {
+	if !s.BoxDrawing || s.pixelChar(c) {
+		return 0, false
+	}
+	if !isBoxRune(c.Rune) {
+		return 0, false
+	}
+	return c.Rune, true
+}
+
+// setupBoxDrawing sets up symbols and uses for box drawing chars, symbols are
+// stretched to the cell so they join without gaps at any line height
+func (s *Screen) setupBoxDrawing() {
+	if !s.BoxDrawing {
+		return
+	}
+	// cell size in pixels if known, otherwise approximate size for line
+	// thickness and aspect ratio
+	w := float64(s.CharacterBoxSize.X)
+	h := float64(s.CharacterBoxSize.Y)
+	if w == 0 {
+		w = math.Round(float64(s.Dom.FontSize) * 0.6)
+		h = math.Round(float64(s.Dom.FontSize) * float64(s.LineHeight))
+	}
+	viewBox := pathNumber(w) + " " + pathNumber(h)
+	s.Dom.BoxWidth = s.columnCoordinate(1, false)
+	s.Dom.BoxHeight = s.rowCoordinate(1, false)
+
+	symbols := map[rune]string{}
+	for _, l := range s.Lines {
+		for col, c := range l.Chars {
+			r, ok := s.boxChar(c)
+			if !ok {
+				continue
+			}
+			id, ok := symbols[r]
+			if !ok {
+				if paths := boxGlyph(r, w, h); len(paths) > 0 {
+					id = fmt.Sprintf("bx%x", r)
+					s.Dom.BoxSymbols = append(s.Dom.BoxSymbols, boxSymbol{ID: id, ViewBox: "0 0 " + viewBox, Paths: paths})
+				}
+				symbols[r] = id
+			}
+			if id == "" {
+				continue
+			}
+			s.Dom.BoxUses = append(s.Dom.BoxUses, glyphUse{
+				ID:    id,
+				X:     s.columnCoordinate(float32(col), true),
+				Y:     s.rowCoordinate(float32(l.Y), true),
+				Class: s.styleClass(c.Attr&AttrDim, s.resolveColor(c.Foreground, &s.Foreground)),
+			})
+		}
+	}
+}
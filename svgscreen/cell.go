@@ -0,0 +1,124 @@
+package svgscreen
+
+import (
+	"strconv"
+
+	"github.com/wader/ansisvg/ansidecoder"
+)
+
+// Color is the default color, an ANSI color, a 256 color, a RGB color or the
+// default color of the other color map packed into 32 bits with kind in the
+// top byte. Zero value is the default color.
+type Color uint32
+
+const (
+	ColorDefault Color = 0
+
+	colorKindANSI    Color = 1 << 24
+	colorKindRGB     Color = 2 << 24
+	colorKindInverse Color = 3 << 24
+	colorKind256     Color = 4 << 24
+	colorKindMask    Color = 0xff << 24
+)
+
+// Used for inverted default colors in CSS variables mode
+const (
+	colorDefaultForeground = colorKindInverse | 1
+	colorDefaultBackground = colorKindInverse | 2
+)
+
+// ANSIColor returns color n of the 16 ANSI colors
+func ANSIColor(n int) Color {
+	return colorKindANSI | Color(n&0xff)
+}
+
+// Color256 returns color n of the 256 color palette, 0-15 are ANSI colors
+func Color256(n int) Color {
+	if n < 16 {
+		return ANSIColor(n)
+	}
+	return colorKind256 | Color(n&0xff)
+}
+
+// RGBColor returns a RGB color
+func RGBColor(r, g, b uint8) Color {
+	return colorKindRGB | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+// HexColor returns RGB color for "#rrggbb", ColorDefault if invalid
+func HexColor(s string) Color {
+	if len(s) != 7 || s[0] != '#' {
+		return ColorDefault
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return ColorDefault
+	}
+	return colorKindRGB | Color(v)
+}
+
+// ANSI returns index and true if c is an ANSI color
+func (c Color) ANSI() (int, bool) {
+	if c&colorKindMask != colorKindANSI {
+		return 0, false
+	}
+	return int(c & 0xff), true
+}
+
+// Index256 returns index and true if c is a 256 color above 15
+func (c Color) Index256() (int, bool) {
+	if c&colorKindMask != colorKind256 {
+		return 0, false
+	}
+	return int(c & 0xff), true
+}
+
+// rgbColor returns a 256 color as the RGB color it is shown as, other colors as is
+func (c Color) rgbColor() Color {
+	if c&colorKindMask != colorKind256 {
+		return c
+	}
+	r, g, b, _ := ansidecoder.Color256(int(c & 0xff)).RGB()
+	return RGBColor(r, g, b)
+}
+
+// RGB returns red, green, blue and true if c is a RGB color or a 256 color
+func (c Color) RGB() (r, g, b uint8, ok bool) {
+	c = c.rgbColor()
+	if c&colorKindMask != colorKindRGB {
+		return 0, 0, 0, false
+	}
+	return uint8(c >> 16), uint8(c >> 8), uint8(c), true
+}
+
+const hexDigits = "0123456789abcdef"
+
+// Hex returns "#rrggbb" for a RGB color or a 256 color and "" otherwise
+func (c Color) Hex() string {
+	c = c.rgbColor()
+	if c&colorKindMask != colorKindRGB {
+		return ""
+	}
+	b := [7]byte{'#'}
+	for i := 0; i < 6; i++ {
+		b[6-i] = hexDigits[(c>>(4*i))&0xf]
+	}
+	return string(b[:])
+}
+
+// Attr is a set of text attributes
+type Attr uint8
+
+const (
+	AttrIntensity Attr = 1 << iota
+	AttrDim
+	AttrItalic
+	AttrUnderline
+	AttrStrikethrough
+	AttrInvert
+)
+
+// Has returns true if all attributes in a are set
+func (at Attr) Has(a Attr) bool {
+	return at&a == a
+}
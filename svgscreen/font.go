@@ -0,0 +1,97 @@
+package svgscreen
+
+import (
+	"encoding/base64"
+	"html/template"
+	"path"
+	"strings"
+)
+
+// fontType returns MIME type and CSS format() hint for font file data, empty
+// if unknown
+func fontType(b []byte) (mime string, format string) {
+	if len(b) < 4 {
+		return "", ""
+	}
+	switch string(b[0:4]) {
+	case "\x00\x01\x00\x00", "true":
+		return "font/ttf", "truetype"
+	case "OTTO":
+		return "font/otf", "opentype"
+	case "wOFF":
+		return "font/woff", "woff"
+	case "wOF2":
+		return "font/woff2", "woff2"
+	case "ttcf":
+		return "font/collection", "collection"
+	}
+	return "", ""
+}
+
+// fontDataURL returns font file as data URL with MIME type
+func fontDataURL(b []byte) template.URL {
+	mime, _ := fontType(b)
+	// base64 data and MIME type are safe
+	return template.URL("data:" + mime + ";base64," + base64.RawStdEncoding.EncodeToString(b)) //nolint:gosec
+}
+
+// fontRefFormat returns CSS format() hint for a font URL based on extension,
+// empty if unknown
+func fontRefFormat(ref string) string {
+	if i := strings.IndexAny(ref, "?#"); i != -1 {
+		ref = ref[:i]
+	}
+	switch strings.ToLower(path.Ext(ref)) {
+	case ".ttf":
+		return "truetype"
+	case ".otf":
+		return "opentype"
+	case ".woff":
+		return "woff"
+	case ".woff2":
+		return "woff2"
+	}
+	return ""
+}
+
+// FontFace is an embedded font file or a reference to one
+type FontFace struct {
+	Embedded []byte
+	Ref      string
+}
+
+func (f FontFace) IsSet() bool {
+	return len(f.Embedded) > 0 || f.Ref != ""
+}
+
+// fontFaceRule is a @font-face rule, Weight and Style are empty for regular
+type fontFaceRule struct {
+	FontFace
+	Weight string
+	Style  string
+}
+
+// FontFaces returns @font-face rules for the regular font and set style variants
+func (d SvgDom) FontFaces() []fontFaceRule {
+	var rules []fontFaceRule
+	for _, r := range []fontFaceRule{
+		{FontFace: FontFace{Embedded: d.FontEmbedded, Ref: d.FontRef}},
+		{FontFace: d.FontBold, Weight: "bold", Style: "normal"},
+		{FontFace: d.FontItalic, Weight: "normal", Style: "italic"},
+		{FontFace: d.FontBoldItalic, Weight: "bold", Style: "italic"},
+	} {
+		if len(r.Embedded) > 0 {
+			// embedded has precedence as before
+			r.Ref = ""
+		}
+		if r.IsSet() {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// UseFakeBold returns true if bold text should be faked with a stroke
+func (d SvgDom) UseFakeBold() bool {
+	return d.FakeBold && !d.FontBold.IsSet()
+}
@@ -0,0 +1,106 @@
+package svgscreen
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+)
+
+//go:embed gallery.svg.tmpl
+var gallerySVGTmpl string
+
+//go:embed gallery.html.tmpl
+var galleryHTMLTmpl string
+
+// GalleryTile is a screen with a label
+type GalleryTile struct {
+	Label  string
+	Screen *Screen
+}
+
+type galleryTileDom struct {
+	Label  string
+	LabelX string
+	LabelY string
+	SVG    template.HTML
+}
+
+type galleryDom struct {
+	Width    string
+	Height   string
+	ViewBox  string
+	FontName string
+	FontSize int
+	Columns  int
+	Tiles    []galleryTileDom
+}
+
+// RenderGallery renders tiles in a grid with labels as one SVG, or as a HTML page if html is true.
+// Tiles are assumed to have the same size and units as the first tile.
+func RenderGallery(w io.Writer, tiles []GalleryTile, columns int, html bool) error {
+	if len(tiles) == 0 {
+		return fmt.Errorf("gallery has no tiles")
+	}
+	if columns < 1 {
+		return fmt.Errorf("gallery columns must be at least 1")
+	}
+	if columns > len(tiles) {
+		columns = len(tiles)
+	}
+
+	first := tiles[0].Screen
+	tileW, tileH, xUnit, yUnit := first.size()
+	gapX, _ := first.columns(2)
+	gapY, _ := first.rows(1)
+	labelH, _ := first.rows(1.5)
+	rows := (len(tiles) + columns - 1) / columns
+
+	dom := galleryDom{
+		FontName: first.Dom.FontName,
+		FontSize: first.Dom.FontSize,
+		Columns:  columns,
+	}
+	width := float32(columns)*(tileW+gapX) + gapX
+	height := float32(rows)*(labelH+tileH+gapY) + gapY
+	dom.Width = fmt.Sprintf("%g%s", width, xUnit)
+	dom.Height = fmt.Sprintf("%g%s", height, yUnit)
+	if first.CharacterBoxSize.X != 0 {
+		dom.ViewBox = fmt.Sprintf("0 0 %g %g", width, height)
+	}
+
+	for i, t := range tiles {
+		x := gapX + float32(i%columns)*(tileW+gapX)
+		y := gapY + float32(i/columns)*(labelH+tileH+gapY) + labelH
+
+		s := t.Screen
+		s.Dom.ID = fmt.Sprintf("tile%d", i)
+		if !html {
+			s.Dom.X = fmt.Sprintf("%g%s", x, xUnit)
+			s.Dom.Y = fmt.Sprintf("%g%s", y, yUnit)
+		}
+		b := &bytes.Buffer{}
+		if err := s.Render(b); err != nil {
+			return err
+		}
+
+		dom.Tiles = append(dom.Tiles, galleryTileDom{
+			Label:  t.Label,
+			LabelX: fmt.Sprintf("%g%s", x, xUnit),
+			LabelY: fmt.Sprintf("%g%s", y-labelH/2, yUnit),
+			// rendered by our own template so safe
+			SVG: template.HTML(b.String()), //nolint:gosec
+		})
+	}
+
+	tmpl := gallerySVGTmpl
+	if html {
+		tmpl = galleryHTMLTmpl
+	}
+	t, err := template.New("").Parse(tmpl)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, dom)
+}
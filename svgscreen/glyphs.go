@@ -0,0 +1,147 @@
+package svgscreen
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wader/ansisvg/sfnt"
+)
+
+// glyphSymbol is a glyph outline in pixels with origin at baseline
+type glyphSymbol struct {
+	ID   string
+	Path string
+}
+
+// glyphUse places a glyphSymbol with its baseline at X, Y
+type glyphUse struct {
+	ID    string
+	X     string
+	Y     string
+	Class string
+}
+
+func pathNumber(v float64) string {
+	v = math.Round(v*100) / 100
+	if v == 0 {
+		// no negative zero
+		v = 0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// glyphPath returns SVG path data for glyph scaled by k, empty if glyph has no outline
+func glyphPath(f *sfnt.Font, gid uint16, k float64, italic bool) string {
+	cs, err := f.Contours(gid)
+	if err != nil {
+		// broken or unsupported glyph, draw nothing
+		return ""
+	}
+	var sb strings.Builder
+	point := func(x, y float32) string {
+		fx := float64(x) * k
+		fy := float64(y) * k
+		if italic {
+			fx += fy * italicSlant
+		}
+		return pathNumber(fx) + " " + pathNumber(-fy)
+	}
+	sfnt.Segments(cs,
+		func(x, y float32) { sb.WriteString("M" + point(x, y)) },
+		func(x, y float32) { sb.WriteString("L" + point(x, y)) },
+		func(cx, cy, x, y float32) { sb.WriteString("Q" + point(cx, cy) + " " + point(x, y)) },
+		func() { sb.WriteString("Z") },
+	)
+	return sb.String()
+}
+
+// setupGlyphs sets up glyph symbols, uses and underline and strikethrough
+// rects instead of text elements
+func (s *Screen) setupGlyphs() {
+	m := newFontMetrics(s.GlyphFont, float64(s.Dom.FontSize))
+	cellW := float64(s.CharacterBoxSize.X)
+	cellH := float64(s.CharacterBoxSize.Y)
+	baseline := m.baseline(cellH)
+	boldOffset := math.Max(0.5, float64(s.Dom.FontSize)/20)
+
+	// underline and strikethrough, adjacent with same style are merged
+	type glyphLine struct {
+		x, y, w float64
+		class   string
+	}
+	var lines []glyphLine
+	// symbols by glyph index as runes can map to the same glyph, ex .notdef
+	type symbolKey struct {
+		gid    uint16
+		italic bool
+	}
+	symbols := map[symbolKey]string{}
+	for _, l := range s.Lines {
+		y := float64(l.Y)*cellH + float64(s.MarginSize.Y)
+		for col, c := range l.Chars {
+			if _, ok := s.boxChar(c); ok || s.pixelChar(c) {
+				continue
+			}
+			x := float64(col)*cellW + float64(s.MarginSize.X)
+
+			class := s.styleClass(c.Attr&AttrDim, s.resolveColor(c.Foreground, &s.Foreground))
+
+			gid := s.GlyphFont.GlyphIndex(c.Rune)
+			k := symbolKey{gid: gid, italic: c.Attr.Has(AttrItalic)}
+			id, ok := symbols[k]
+			if !ok {
+				if p := glyphPath(s.GlyphFont, gid, m.k, c.Attr.Has(AttrItalic)); p != "" {
+					id = fmt.Sprintf("g%d", gid)
+					if c.Attr.Has(AttrItalic) {
+						id += "i"
+					}
+					s.Dom.GlyphSymbols = append(s.Dom.GlyphSymbols, glyphSymbol{ID: id, Path: p})
+				}
+				symbols[k] = id
+			}
+			if id != "" {
+				s.Dom.GlyphUses = append(s.Dom.GlyphUses, glyphUse{
+					ID:    id,
+					X:     pathNumber(x),
+					Y:     pathNumber(y + baseline),
+					Class: class,
+				})
+				if c.Attr.Has(AttrIntensity) {
+					// fake bold by drawing glyph twice
+					s.Dom.GlyphUses = append(s.Dom.GlyphUses, glyphUse{
+						ID:    id,
+						X:     pathNumber(x + boldOffset),
+						Y:     pathNumber(y + baseline),
+						Class: class,
+					})
+				}
+			}
+
+			lineY := 0.0
+			switch {
+			case c.Attr.Has(AttrUnderline):
+				lineY = baseline + m.underline()
+			case c.Attr.Has(AttrStrikethrough):
+				lineY = baseline + m.strikethrough()
+			default:
+				continue
+			}
+			if n := len(lines); n > 0 && lines[n-1].y == y+lineY && lines[n-1].x+lines[n-1].w == x && lines[n-1].class == class {
+				lines[n-1].w += cellW
+				continue
+			}
+			lines = append(lines, glyphLine{x: x, y: y + lineY, w: cellW, class: class})
+		}
+	}
+	for _, gl := range lines {
+		s.Dom.GlyphLines = append(s.Dom.GlyphLines, bgRect{
+			X:      pathNumber(gl.x),
+			Y:      pathNumber(gl.y),
+			Width:  pathNumber(gl.w),
+			Height: pathNumber(m.lineThickness()),
+			Color:  gl.class,
+		})
+	}
+}
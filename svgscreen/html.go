@@ -0,0 +1,255 @@
+package svgscreen
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+//go:embed svgscreen.html.tmpl
+var screenHTMLTmpl string
+
+// HTMLOptions are options for RenderHTML
+type HTMLOptions struct {
+	// Fragment renders only the <pre> element and its style, no document
+	Fragment bool
+	// InlineStyles uses style attributes instead of classes, @font-face is still a <style>
+	InlineStyles bool
+}
+
+type htmlSpan struct {
+	Class      string
+	Style      template.CSS
+	Content    string
+	background bool
+}
+
+type htmlLine struct {
+	Spans []htmlSpan
+}
+
+type htmlDom struct {
+	*Screen
+	HTMLOptions
+	// Declarations for the <pre> element
+	RootStyle []template.CSS
+	Lines     []htmlLine
+}
+
+// htmlStyle is the style of a span of chars
+type htmlStyle struct {
+	fg            string
+	bg            string
+	fgValue       template.CSS
+	bgValue       template.CSS
+	bold          bool
+	dim           bool
+	italic        bool
+	underline     bool
+	strikethrough bool
+}
+
+func (s *Screen) charToHTMLStyle(c Char) htmlStyle {
+	hs := htmlStyle{
+		fg:            s.resolveColor(c.Foreground, &s.Foreground),
+		bold:          c.Attr.Has(AttrIntensity),
+		dim:           c.Attr.Has(AttrDim),
+		italic:        c.Attr.Has(AttrItalic),
+		underline:     c.Attr.Has(AttrUnderline),
+		strikethrough: c.Attr.Has(AttrStrikethrough) && !c.Attr.Has(AttrUnderline),
+	}
+	if hs.fg != "" {
+		hs.fgValue = s.colorValue(c.Foreground)
+	}
+	if !s.Background.isDefault(c.Background) {
+		hs.bg = s.resolveColor(c.Background, &s.Background)
+		hs.bgValue = s.colorValue(c.Background)
+	}
+	s.Dom.ClassesUsed.Bold = s.Dom.ClassesUsed.Bold || hs.bold
+	s.Dom.ClassesUsed.Dim = s.Dom.ClassesUsed.Dim || hs.dim
+	s.Dom.ClassesUsed.Italic = s.Dom.ClassesUsed.Italic || hs.italic
+	s.Dom.ClassesUsed.Underline = s.Dom.ClassesUsed.Underline || hs.underline
+	s.Dom.ClassesUsed.Strikethrough = s.Dom.ClassesUsed.Strikethrough || hs.strikethrough
+	return hs
+}
+
+func (hs htmlStyle) class() string {
+	var classes []string
+	for _, c := range []struct {
+		used bool
+		name string
+	}{
+		{hs.bold, "bold"},
+		{hs.dim, "dim"},
+		{hs.italic, "italic"},
+		{hs.underline, "underline"},
+		{hs.strikethrough, "strikethrough"},
+		{hs.fg != "", hs.fg},
+		{hs.bg != "", hs.bg},
+	} {
+		if c.used {
+			classes = append(classes, c.name)
+		}
+	}
+	return strings.Join(classes, " ")
+}
+
+// colorValue returns CSS value for a color that is not the default color
+func (s *Screen) colorValue(c Color) template.CSS {
+	switch c {
+	case colorDefaultForeground:
+		return s.cssValue(ForegroundVariableName, s.Foreground.Default)
+	case colorDefaultBackground:
+		return s.cssValue(BackgroundVariableName, s.Background.Default)
+	}
+	if idx, ok := c.ANSI(); ok {
+		return s.cssValue(ANSIVariableNames[idx], s.ANSIColors[idx])
+	}
+	// hex is always safe
+	return template.CSS(c.Hex()) //nolint:gosec
+}
+
+func (s *Screen) inlineStyle(hs htmlStyle) template.CSS {
+	var decls []string
+	if hs.fg != "" {
+		decls = append(decls, "color: "+string(hs.fgValue))
+	}
+	if hs.bg != "" {
+		decls = append(decls, "background-color: "+string(hs.bgValue))
+	}
+	if hs.bold {
+		if s.Dom.UseFakeBold() {
+			// stroke is current color by default
+			decls = append(decls, "-webkit-text-stroke: 0.05em")
+		} else {
+			decls = append(decls, "font-weight: bold")
+		}
+	}
+	if hs.dim {
+		decls = append(decls, "opacity: "+string(s.cssValue(DimOpacityVariableName, "0.5")))
+	}
+	if hs.italic {
+		decls = append(decls, "font-style: italic")
+	}
+	if hs.underline {
+		decls = append(decls, "text-decoration: underline")
+	} else if hs.strikethrough {
+		decls = append(decls, "text-decoration: line-through")
+	}
+	// values are from cssValue so safe
+	return template.CSS(strings.Join(decls, "; ")) //nolint:gosec
+}
+
+// lineToHTMLLine consolidates chars with same style into spans
+func (s *Screen) lineToHTMLLine(l Line, inlineStyles bool) htmlLine {
+	var hl htmlLine
+	var current htmlStyle
+	var content strings.Builder
+
+	appendSpan := func() {
+		if content.Len() == 0 {
+			return
+		}
+		span := htmlSpan{Content: content.String(), background: current.bg != ""}
+		if inlineStyles {
+			span.Style = s.inlineStyle(current)
+		} else {
+			span.Class = current.class()
+		}
+		hl.Spans = append(hl.Spans, span)
+		content.Reset()
+	}
+	for _, c := range l.Chars {
+		hs := s.charToHTMLStyle(c)
+		if hs != current {
+			appendSpan()
+			current = hs
+		}
+		content.WriteRune(c.Rune)
+	}
+	appendSpan()
+
+	// remove trailing whitespace without background
+	for len(hl.Spans) > 0 {
+		last := &hl.Spans[len(hl.Spans)-1]
+		if last.background {
+			break
+		}
+		last.Content = strings.TrimRight(last.Content, " ")
+		if last.Content != "" {
+			break
+		}
+		hl.Spans = hl.Spans[:len(hl.Spans)-1]
+	}
+
+	return hl
+}
+
+func (s *Screen) htmlRootStyle() []template.CSS {
+	fontFamily := "monospace"
+	if s.Dom.FontName != "" {
+		fontFamily = s.Dom.FontName + ", " + fontFamily
+	}
+	decls := []string{
+		"font-family: " + fontFamily,
+		fmt.Sprintf("font-size: %dpx", s.Dom.FontSize),
+		"line-height: " + s.rowCoordinate(1, false),
+		"font-variant-ligatures: none",
+		"display: inline-block",
+		"min-width: " + s.columnCoordinate(float32(s.TerminalWidth), false),
+		"margin: 0",
+		"padding: " + s.rowCoordinate(0, true) + " " + s.columnCoordinate(0, true),
+		"color: " + string(s.cssValue(ForegroundVariableName, s.Foreground.Default)),
+	}
+	if !s.Transparent {
+		decls = append(decls, "background-color: "+string(s.cssValue(BackgroundVariableName, s.Background.Default)))
+	}
+	var css []template.CSS
+	for _, d := range decls {
+		// font name is set by us, sizes are numbers and colors are from cssValue
+		css = append(css, template.CSS(d)) //nolint:gosec
+	}
+	return css
+}
+
+// RenderHTML renders screen as a HTML document or fragment with a <pre> element
+// and text runs with same style as <span>
+func (s *Screen) RenderHTML(w io.Writer, opts HTMLOptions) error {
+	if err := s.checkColors(); err != nil {
+		return err
+	}
+	t := template.New("")
+	t.Funcs(s.templateFuncs())
+
+	s.Foreground.reset("f")
+	s.Background.reset("b")
+
+	s.handleColorInversion(s.useVariables())
+	s.enforceMinimumContrast()
+
+	dom := htmlDom{
+		Screen:      s,
+		HTMLOptions: opts,
+		RootStyle:   s.htmlRootStyle(),
+	}
+	rows := make([]htmlLine, s.NrLines)
+	for _, l := range s.Lines {
+		for len(rows) <= l.Y {
+			rows = append(rows, htmlLine{})
+		}
+		rows[l.Y] = s.lineToHTMLLine(l, opts.InlineStyles)
+	}
+	dom.Lines = rows
+
+	s.setupVariables()
+	setupCustomColors(s.Foreground.Custom, &s.Dom.FgCustomColors)
+	setupCustomColors(s.Background.Custom, &s.Dom.BgCustomColors)
+
+	t, err := t.Parse(screenHTMLTmpl)
+	if err != nil {
+		return err
+	}
+	return t.ExecuteTemplate(w, "", dom)
+}
@@ -0,0 +1,256 @@
+package svgscreen
+
+import (
+	"fmt"
+	"image"
+	imagecolor "image/color"
+	"image/draw"
+	"math"
+
+	"github.com/wader/ansisvg/color"
+	"github.com/wader/ansisvg/internal/fixedfont"
+	"github.com/wader/ansisvg/internal/raster"
+	"github.com/wader/ansisvg/sfnt"
+)
+
+// ImageOptions are options for Image
+type ImageOptions struct {
+	// Font is used instead of the built-in 7x13 bitmap font, FontSize is the size in pixels
+	Font *sfnt.Font
+	// Scale multiplies all sizes, ex 2 for HiDPI displays
+	Scale int
+}
+
+// MaxImagePixels is max number of pixels of an image, about 1GB of memory
+const MaxImagePixels = 1 << 28
+
+// italicSlant is horizontal shift per pixel above baseline for fake italic
+const italicSlant = 0.2
+
+type glyphKey struct {
+	r      rune
+	italic bool
+}
+
+// imageFace draws glyphs and knows cell geometry in pixels
+type imageFace struct {
+	cellW, cellH int
+	// baseline from top of cell
+	baseline int
+	// underline and strikethrough top from top of cell and thickness
+	underline, strikethrough, lineThickness int
+	// bold offset in pixels
+	boldOffset int
+
+	glyph func(k glyphKey) *image.Alpha
+	cache map[glyphKey]*image.Alpha
+}
+
+func (f *imageFace) mask(k glyphKey) *image.Alpha {
+	if m, ok := f.cache[k]; ok {
+		return m
+	}
+	m := f.glyph(k)
+	f.cache[k] = m
+	return m
+}
+
+func (s *Screen) bitmapFace(scale int) *imageFace {
+	cellW := fixedfont.Width
+	cellH := int(math.Round(fixedfont.Height * float64(s.LineHeight)))
+	if s.CharacterBoxSize.X > 0 {
+		cellW = s.CharacterBoxSize.X
+		cellH = s.CharacterBoxSize.Y
+	}
+	// glyph is centered vertically in the cell
+	top := (cellH - fixedfont.Height) / 2
+	baseline := top + fixedfont.Ascent
+
+	return &imageFace{
+		cellW:         cellW * scale,
+		cellH:         cellH * scale,
+		baseline:      baseline * scale,
+		underline:     (baseline + 1) * scale,
+		strikethrough: (baseline - 4) * scale,
+		lineThickness: scale,
+		boldOffset:    scale,
+		cache:         map[glyphKey]*image.Alpha{},
+		glyph: func(k glyphKey) *image.Alpha {
+			g, ok := fixedfont.Glyph(k.r)
+			if !ok {
+				g, _ = fixedfont.Glyph('�')
+			}
+			// mask is two cells wide so a glyph can overflow into next cell
+			m := image.NewAlpha(image.Rect(0, 0, cellW*2*scale, cellH*scale))
+			for y := 0; y < fixedfont.Height; y++ {
+				shift := 0
+				if k.italic {
+					shift = int(float64(fixedfont.Ascent-y) * italicSlant)
+				}
+				for x := 0; x < fixedfont.Width; x++ {
+					if g.Pix[y*g.Stride+x] == 0 {
+						continue
+					}
+					r := image.Rect(x+shift, top+y, x+shift+1, top+y+1)
+					draw.Draw(m, image.Rect(r.Min.X*scale, r.Min.Y*scale, r.Max.X*scale, r.Max.Y*scale), image.Opaque, image.Point{}, draw.Src)
+				}
+			}
+			return m
+		},
+	}
+}
+
+func (s *Screen) fontFace(f *sfnt.Font, scale int) *imageFace {
+	px := float64(s.Dom.FontSize * scale)
+	m := newFontMetrics(f, px)
+	k := m.k
+	cellW := int(math.Round(m.advance))
+	cellH := int(math.Round(m.height * float64(s.LineHeight)))
+	if s.CharacterBoxSize.X > 0 {
+		cellW = s.CharacterBoxSize.X * scale
+		cellH = s.CharacterBoxSize.Y * scale
+	}
+	if cellW < 1 {
+		cellW = 1
+	}
+	baseline := int(math.Round(m.baseline(float64(cellH))))
+	lineThickness := int(math.Round(m.lineThickness()))
+	underline := baseline + int(math.Round(m.underline()))
+	strikethrough := baseline + int(math.Round(m.strikethrough()))
+	boldOffset := int(math.Round(px / 20))
+	if boldOffset < 1 {
+		boldOffset = 1
+	}
+
+	return &imageFace{
+		cellW:         cellW,
+		cellH:         cellH,
+		baseline:      baseline,
+		underline:     underline,
+		strikethrough: strikethrough,
+		lineThickness: lineThickness,
+		boldOffset:    boldOffset,
+		cache:         map[glyphKey]*image.Alpha{},
+		glyph: func(gk glyphKey) *image.Alpha {
+			r := raster.New(cellW*2, cellH)
+			cs, err := f.Contours(f.GlyphIndex(gk.r))
+			if err != nil {
+				// broken or unsupported glyph, draw nothing
+				return r.Mask()
+			}
+			tr := func(x, y float32) (float32, float32) {
+				fx := float64(x) * k
+				fy := float64(y) * k
+				if gk.italic {
+					fx += fy * italicSlant
+				}
+				return float32(fx), float32(float64(baseline) - fy)
+			}
+			sfnt.Segments(cs,
+				func(x, y float32) { r.MoveTo(tr(x, y)) },
+				func(x, y float32) { r.LineTo(tr(x, y)) },
+				func(cx, cy, x, y float32) {
+					tcx, tcy := tr(cx, cy)
+					tx, ty := tr(x, y)
+					r.QuadTo(tcx, tcy, tx, ty)
+				},
+				r.Close,
+			)
+			return r.Mask()
+		},
+	}
+}
+
+func rgba(hex string) imagecolor.RGBA {
+	c := color.NewFromHex(hex)
+	return imagecolor.RGBA{
+		R: uint8(c.R*255 + 0.5),
+		G: uint8(c.G*255 + 0.5),
+		B: uint8(c.B*255 + 0.5),
+		A: 0xff,
+	}
+}
+
+// Image renders screen as an image using the built-in bitmap font or opts.Font.
+// Fails if opts.Scale is less than 1 or the image would be larger than MaxImagePixels.
+func (s *Screen) Image(opts ImageOptions) (*image.RGBA, error) {
+	scale := opts.Scale
+	if scale < 1 {
+		return nil, fmt.Errorf("scale must be at least 1")
+	}
+	var face *imageFace
+	if opts.Font != nil {
+		face = s.fontFace(opts.Font, scale)
+	} else {
+		face = s.bitmapFace(scale)
+	}
+
+	marginX := int(math.Round(float64(s.MarginSize.X) * float64(face.cellW)))
+	marginY := int(math.Round(float64(s.MarginSize.Y) * float64(face.cellH)))
+	if s.CharacterBoxSize.X > 0 {
+		marginX = int(math.Round(float64(s.MarginSize.X) * float64(scale)))
+		marginY = int(math.Round(float64(s.MarginSize.Y) * float64(scale)))
+	}
+	cellRect := func(x, y int) image.Rectangle {
+		return image.Rect(0, 0, face.cellW, face.cellH).Add(image.Pt(marginX+x*face.cellW, marginY+y*face.cellH))
+	}
+
+	width := s.TerminalWidth*face.cellW + 2*marginX
+	height := s.NrLines*face.cellH + 2*marginY
+	if width < 0 || height < 0 || float64(width)*float64(height) > MaxImagePixels {
+		return nil, fmt.Errorf("image size %dx%d is larger than max %d pixels", width, height, MaxImagePixels)
+	}
+
+	s.handleColorInversion(s.useVariables())
+	s.enforceMinimumContrast()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if !s.Transparent {
+		draw.Draw(img, img.Bounds(), image.NewUniform(rgba(s.Background.Default)), image.Point{}, draw.Src)
+	}
+
+	// backgrounds first so glyphs overflowing into next cell are not covered
+	for _, l := range s.Lines {
+		for x, c := range l.Chars {
+			if s.Background.isDefault(c.Background) {
+				continue
+			}
+			draw.Draw(img, cellRect(x, l.Y), image.NewUniform(rgba(s.colorHex(c.Background, &s.Background))), image.Point{}, draw.Src)
+		}
+	}
+
+	for _, l := range s.Lines {
+		for x, c := range l.Chars {
+			fg := rgba(s.colorHex(c.Foreground, &s.Foreground))
+			if c.Attr.Has(AttrDim) {
+				// same as opacity 0.5, premultiplied
+				fg = imagecolor.RGBA{R: fg.R / 2, G: fg.G / 2, B: fg.B / 2, A: 0x80}
+			}
+			src := image.NewUniform(fg)
+			cr := cellRect(x, l.Y)
+
+			r := c.Rune
+			if r != ' ' {
+				m := face.mask(glyphKey{r: r, italic: c.Attr.Has(AttrItalic)})
+				dr := m.Bounds().Add(cr.Min)
+				draw.DrawMask(img, dr, src, image.Point{}, m, image.Point{}, draw.Over)
+				if c.Attr.Has(AttrIntensity) {
+					draw.DrawMask(img, dr.Add(image.Pt(face.boldOffset, 0)), src, image.Point{}, m, image.Point{}, draw.Over)
+				}
+			}
+
+			lineY := -1
+			if c.Attr.Has(AttrUnderline) {
+				lineY = face.underline
+			} else if c.Attr.Has(AttrStrikethrough) {
+				lineY = face.strikethrough
+			}
+			if lineY >= 0 {
+				lr := image.Rect(cr.Min.X, cr.Min.Y+lineY, cr.Max.X, cr.Min.Y+lineY+face.lineThickness).Intersect(cr)
+				draw.Draw(img, lr, src, image.Point{}, draw.Over)
+			}
+		}
+	}
+
+	return img, nil
+}
@@ -0,0 +1,113 @@
+package svgscreen
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONVersion is the version of the JSON schema, bumped on incompatible changes
+const JSONVersion = 1
+
+// JSONScreen is the top level object of RenderJSON output
+type JSONScreen struct {
+	Version int `json:"version"`
+	// Columns is the terminal width and Rows the number of lines
+	Columns int         `json:"columns"`
+	Rows    int         `json:"rows"`
+	Title   string      `json:"title,omitempty"`
+	Palette JSONPalette `json:"palette"`
+	// Lines are only lines with cells, ordered by Y
+	Lines []JSONLine `json:"lines"`
+}
+
+// JSONPalette is the color scheme used to resolve colors
+type JSONPalette struct {
+	Foreground string     `json:"foreground"`
+	Background string     `json:"background"`
+	ANSI       [16]string `json:"ansi"`
+}
+
+type JSONLine struct {
+	Y     int        `json:"y"`
+	Cells []JSONCell `json:"cells"`
+}
+
+// JSONCell is one cell, colors are as displayed so inverse is already applied
+type JSONCell struct {
+	X             int       `json:"x"`
+	Char          string    `json:"char"`
+	Foreground    JSONColor `json:"fg"`
+	Background    JSONColor `json:"bg"`
+	Bold          bool      `json:"bold,omitempty"`
+	Dim           bool      `json:"dim,omitempty"`
+	Italic        bool      `json:"italic,omitempty"`
+	Underline     bool      `json:"underline,omitempty"`
+	Strikethrough bool      `json:"strikethrough,omitempty"`
+	Inverse       bool      `json:"inverse,omitempty"`
+}
+
+// JSONColor is a resolved color. Index is set for the 16 palette colors and
+// 256 colors and Default for the scheme default foreground or background color.
+// Truecolor only has Hex.
+type JSONColor struct {
+	Hex     string `json:"hex"`
+	Index   *int   `json:"index,omitempty"`
+	Default bool   `json:"default,omitempty"`
+}
+
+func (s *Screen) jsonColor(c Color, cmap *ColorMap) JSONColor {
+	jc := JSONColor{Hex: s.colorHex(c, cmap)}
+	if idx, ok := c.ANSI(); ok {
+		jc.Index = &idx
+	} else if idx, ok := c.Index256(); ok {
+		jc.Index = &idx
+	} else if c == ColorDefault || c == colorDefaultForeground || c == colorDefaultBackground {
+		jc.Default = true
+	}
+	return jc
+}
+
+// RenderJSON writes decoded screen as JSON, see JSONScreen for schema
+func (s *Screen) RenderJSON(w io.Writer) error {
+	// inverted default colors are kept as markers so they are reported as default
+	s.handleColorInversion(true)
+	s.enforceMinimumContrast()
+
+	js := JSONScreen{
+		Version: JSONVersion,
+		Columns: s.TerminalWidth,
+		Rows:    s.NrLines,
+		Title:   s.Dom.Title,
+		Palette: JSONPalette{
+			Foreground: s.Foreground.Default,
+			Background: s.Background.Default,
+			ANSI:       s.ANSIColors,
+		},
+		Lines: []JSONLine{},
+	}
+	for _, l := range s.Lines {
+		if len(l.Chars) == 0 {
+			continue
+		}
+		jl := JSONLine{Y: l.Y}
+		for x, c := range l.Chars {
+			jl.Cells = append(jl.Cells, JSONCell{
+				X:             x,
+				Char:          string(c.Rune),
+				Foreground:    s.jsonColor(c.Foreground, &s.Foreground),
+				Background:    s.jsonColor(c.Background, &s.Background),
+				Bold:          c.Attr.Has(AttrIntensity),
+				Dim:           c.Attr.Has(AttrDim),
+				Italic:        c.Attr.Has(AttrItalic),
+				Underline:     c.Attr.Has(AttrUnderline),
+				Strikethrough: c.Attr.Has(AttrStrikethrough),
+				Inverse:       c.Attr.Has(AttrInvert),
+			})
+		}
+		js.Lines = append(js.Lines, jl)
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(js)
+}
@@ -0,0 +1,96 @@
+package svgscreen
+
+import (
+	"math"
+
+	"github.com/wader/ansisvg/sfnt"
+)
+
+// fontMetrics is geometry of a font at a size in pixels
+type fontMetrics struct {
+	f *sfnt.Font
+	// k converts font units to pixels
+	k float64
+	// advance is width of "M" and height is ascender to descender plus line gap
+	advance float64
+	height  float64
+}
+
+func newFontMetrics(f *sfnt.Font, px float64) fontMetrics {
+	k := px / float64(f.UnitsPerEm)
+	return fontMetrics{
+		f:       f,
+		k:       k,
+		advance: float64(f.Advance(f.GlyphIndex('M'))) * k,
+		height:  float64(f.Ascender-f.Descender+f.LineGap) * k,
+	}
+}
+
+// baseline returns baseline from top of a cell with font centered vertically
+func (m fontMetrics) baseline(cellH float64) float64 {
+	return (cellH-m.height)/2 + float64(m.f.Ascender+m.f.LineGap/2)*m.k
+}
+
+// lineThickness returns underline and strikethrough thickness, at least 1px
+func (m fontMetrics) lineThickness() float64 {
+	return math.Max(1, float64(m.f.UnderlineThickness)*m.k)
+}
+
+// underline returns top of underline relative to baseline, positive is down
+func (m fontMetrics) underline() float64 {
+	if m.f.UnderlinePosition == 0 {
+		return 1
+	}
+	return -float64(m.f.UnderlinePosition) * m.k
+}
+
+// strikethrough returns top of strikethrough relative to baseline, positive is down
+func (m fontMetrics) strikethrough() float64 {
+	if m.f.StrikeoutPosition == 0 {
+		return -float64(m.f.Ascender) * m.k * 0.3
+	}
+	return -float64(m.f.StrikeoutPosition)*m.k - m.lineThickness()
+}
+
+// textMetrics positions text elements using font metrics
+type textMetrics struct {
+	// LetterSpacing makes glyph advances match a rounded cell width
+	LetterSpacing   string
+	UnderlineOffset string
+	LineThickness   string
+	// baseline from top of row as fraction of row height
+	baseline float32
+}
+
+// UseFontMetrics sets pixel cell size from font advance and height times line
+// height and converts margins from font-relative units to pixels. Text is then
+// positioned at the font baseline with the font underline position and
+// thickness. Does nothing if CharacterBoxSize is already set.
+func (s *Screen) UseFontMetrics(f *sfnt.Font) {
+	if s.CharacterBoxSize.X > 0 {
+		return
+	}
+	m := newFontMetrics(f, float64(s.Dom.FontSize))
+	s.CharacterBoxSize.X = int(math.Max(1, math.Round(m.advance)))
+	s.CharacterBoxSize.Y = int(math.Max(1, math.Round(m.height*float64(s.LineHeight))))
+	s.MarginSize.X *= float32(s.CharacterBoxSize.X)
+	s.MarginSize.Y *= float32(s.Dom.FontSize)
+	s.metrics = &m
+}
+
+func (s *Screen) setupTextMetrics() {
+	if s.metrics == nil {
+		return
+	}
+	m := s.metrics
+	cellH := float64(s.CharacterBoxSize.Y)
+	tm := &textMetrics{
+		UnderlineOffset: pathNumber(m.underline()) + "px",
+		LineThickness:   pathNumber(m.lineThickness()) + "px",
+		baseline:        float32(m.baseline(cellH) / cellH),
+	}
+	if ls := float64(s.CharacterBoxSize.X) - m.advance; math.Abs(ls) >= 0.005 {
+		tm.LetterSpacing = pathNumber(ls) + "px"
+	}
+	s.Dom.TextMetrics = tm
+}
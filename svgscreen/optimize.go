@@ -0,0 +1,88 @@
+package svgscreen
+
+import (
+	"bytes"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// shortClassNames are class names used when optimizing, single upper case
+// letter so they don't collide with color class names
+var shortClassNames = map[string]string{
+	"bold":          "B",
+	"dim":           "D",
+	"italic":        "I",
+	"underline":     "U",
+	"strikethrough": "S",
+	"glyphs":        "G",
+	"box":           "X",
+	"bx":            "H",
+	"px":            "P",
+}
+
+// class returns name of a style class, short name if optimizing
+func (s *Screen) class(name string) string {
+	if s.Optimize {
+		if n, ok := shortClassNames[name]; ok {
+			return n
+		}
+	}
+	return name
+}
+
+// colorClass returns class name of color idx, kind is DOM prefix and "a" for
+// ANSI or "c" for custom colors, ex "fa1". If optimizing ANSI colors are
+// lower case DOM prefix and index, ex "f1", and custom colors upper case, ex "F1"
+func (s *Screen) colorClass(kind string, idx int) string {
+	if s.Optimize && len(kind) == 2 {
+		switch kind[1] {
+		case 'a':
+			return kind[0:1] + strconv.Itoa(idx)
+		case 'c':
+			return strings.ToUpper(kind[0:1]) + strconv.Itoa(idx)
+		}
+	}
+	return kind + strconv.Itoa(idx)
+}
+
+// coordinate formats a coordinate, rounded to two decimals if optimizing
+func (s *Screen) coordinate(v float32, unit string) string {
+	return string(s.appendCoordinate(nil, v, unit))
+}
+
+// appendCoordinate appends a formatted coordinate to b
+func (s *Screen) appendCoordinate(b []byte, v float32, unit string) []byte {
+	if s.Optimize {
+		b = strconv.AppendFloat(b, math.Round(float64(v)*100)/100, 'f', -1, 64)
+	} else {
+		b = strconv.AppendFloat(b, float64(v), 'g', -1, 32)
+	}
+	return append(b, unit...)
+}
+
+var (
+	minifyComment    = regexp.MustCompile(`(?s)<!--.*?-->`)
+	minifyStyle      = regexp.MustCompile(`(?s)<style>.*?</style>`)
+	minifyCSS        = regexp.MustCompile(`\s*([{};:,])\s*`)
+	minifyStyleAttr  = regexp.MustCompile(` style="[^"]*"`)
+	minifyBetweenTag = regexp.MustCompile(`>\s*\n\s*<`)
+)
+
+// minifySVG removes comments, indentation and line breaks between tags and
+// whitespace in CSS and style attributes. Text content never has line breaks
+// and has < and " escaped so it is kept as is.
+func minifySVG(b []byte) []byte {
+	b = minifyComment.ReplaceAll(b, nil)
+	b = minifyStyle.ReplaceAllFunc(b, func(style []byte) []byte {
+		css := bytes.TrimSpace(style[len("<style>") : len(style)-len("</style>")])
+		css = minifyCSS.ReplaceAll(css, []byte("$1"))
+		css = bytes.ReplaceAll(css, []byte(";}"), []byte("}"))
+		return append(append([]byte("<style>"), css...), "</style>"...)
+	})
+	b = minifyStyleAttr.ReplaceAllFunc(b, func(attr []byte) []byte {
+		return minifyCSS.ReplaceAll(attr, []byte("$1"))
+	})
+	return minifyBetweenTag.ReplaceAll(b, []byte("><"))
+}
@@ -0,0 +1,413 @@
+package svgscreen
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/wader/ansisvg/color"
+	"github.com/wader/ansisvg/sfnt"
+)
+
+// PDFOptions are options for RenderPDF
+type PDFOptions struct {
+	// Font and FontData is embedded and used instead of the standard Courier fonts
+	Font     *sfnt.Font
+	FontData []byte
+	// PageWidth in points scales the page to this width, 0 uses 1px as 0.75pt
+	PageWidth float64
+}
+
+// pxToPt is CSS px to PDF points
+const pxToPt = 0.75
+
+// Standard 14 Courier fonts, same metrics for all variants
+var pdfCourierFonts = [4]string{"Courier", "Courier-Bold", "Courier-Oblique", "Courier-BoldOblique"}
+
+const (
+	pdfCourierAdvance   = 600
+	pdfCourierAscender  = 629
+	pdfCourierDescender = -157
+)
+
+// pdfWriter writes numbered objects and keeps track of offsets for the xref table
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+// reserve reserves an object number to be written later
+func (pw *pdfWriter) reserve() int {
+	pw.offsets = append(pw.offsets, 0)
+	return len(pw.offsets)
+}
+
+func (pw *pdfWriter) object(n int, format string, args ...any) {
+	pw.offsets[n-1] = pw.buf.Len()
+	fmt.Fprintf(&pw.buf, "%d 0 obj\n", n)
+	fmt.Fprintf(&pw.buf, format, args...)
+	pw.buf.WriteString("\nendobj\n")
+}
+
+// stream writes a compressed stream object, extra is additional dictionary entries
+func (pw *pdfWriter) stream(n int, extra string, data []byte) {
+	zb := &bytes.Buffer{}
+	zw := zlib.NewWriter(zb)
+	_, _ = zw.Write(data)
+	_ = zw.Close()
+	pw.object(n, "<< /Length %d /Filter /FlateDecode%s >>\nstream\n%s\nendstream", zb.Len(), extra, zb.Bytes())
+}
+
+func (pw *pdfWriter) writeTo(w io.Writer, root int, info int) error {
+	xref := pw.buf.Len()
+	fmt.Fprintf(&pw.buf, "xref\n0 %d\n0000000000 65535 f \n", len(pw.offsets)+1)
+	for _, o := range pw.offsets {
+		fmt.Fprintf(&pw.buf, "%010d 00000 n \n", o)
+	}
+	fmt.Fprintf(&pw.buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(pw.offsets)+1, root, info, xref)
+	_, err := w.Write(pw.buf.Bytes())
+	return err
+}
+
+// pdfNumber formats number with at most 3 decimals
+func pdfNumber(f float64) string {
+	s := fmt.Sprintf("%.3f", f)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// pdfString is a literal string with escapes, bytes above 0x7e are octal escaped
+func pdfString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('(')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c < 0x20 || c > 0x7e:
+			fmt.Fprintf(&sb, "\\%03o", c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+// pdfTextString encodes s as UTF-16BE with BOM, used for document info
+func pdfTextString(s string) string {
+	bs := []byte{0xfe, 0xff}
+	for _, u := range utf16Encode([]rune(s)) {
+		bs = append(bs, byte(u>>8), byte(u))
+	}
+	return pdfString(string(bs))
+}
+
+// winAnsi maps rune to WinAnsiEncoding used by standard fonts, ? if not encodable
+func winAnsi(r rune) byte {
+	switch {
+	case r >= 0x20 && r <= 0x7e, r >= 0xa0 && r <= 0xff:
+		return byte(r)
+	}
+	for i, c := range winAnsiHigh {
+		if c == r {
+			return byte(0x80 + i)
+		}
+	}
+	return '?'
+}
+
+// WinAnsiEncoding 0x80-0x9f
+var winAnsiHigh = [32]rune{
+	'€', 0, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0, 'Ž', 0,
+	0, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0, 'ž', 'Ÿ',
+}
+
+// pdfColor returns non-stroking color operator for hex color
+func pdfColor(c color.Color) string {
+	return fmt.Sprintf("%s %s %s rg", pdfNumber(float64(c.R)), pdfNumber(float64(c.G)), pdfNumber(float64(c.B)))
+}
+
+// RenderPDF renders screen as a single page PDF with selectable text
+func (s *Screen) RenderPDF(w io.Writer, opts PDFOptions) error {
+	s.handleColorInversion(s.useVariables())
+	s.enforceMinimumContrast()
+
+	fontSize := float64(s.Dom.FontSize) * pxToPt
+	advance, ascender, descender, unitsPerEm := pdfCourierAdvance, pdfCourierAscender, pdfCourierDescender, 1000
+	if opts.Font != nil {
+		f := opts.Font
+		advance = f.Advance(f.GlyphIndex('M'))
+		ascender, descender, unitsPerEm = f.Ascender, f.Descender, f.UnitsPerEm
+	}
+	k := fontSize / float64(unitsPerEm)
+	cellW := float64(advance) * k
+	cellH := fontSize * float64(s.LineHeight)
+	if s.CharacterBoxSize.X > 0 {
+		cellW = float64(s.CharacterBoxSize.X) * pxToPt
+		cellH = float64(s.CharacterBoxSize.Y) * pxToPt
+	}
+	marginX := float64(s.MarginSize.X) * cellW
+	marginY := float64(s.MarginSize.Y) * cellH
+	if s.CharacterBoxSize.X > 0 {
+		marginX = float64(s.MarginSize.X) * pxToPt
+		marginY = float64(s.MarginSize.Y) * pxToPt
+	}
+	width := float64(s.TerminalWidth)*cellW + 2*marginX
+	height := float64(s.NrLines)*cellH + 2*marginY
+	scale := 1.0
+	if opts.PageWidth > 0 && width > 0 {
+		scale = opts.PageWidth / width
+	}
+	// same as SVG dominant-baseline central, middle of ascender and descender at middle of row
+	baseline := cellH/2 + float64(ascender+descender)/2*k
+
+	// y grows down from top of page, flipped in the transformation matrix
+	c := &bytes.Buffer{}
+	fmt.Fprintf(c, "%s 0 0 %s 0 %s cm\n", pdfNumber(scale), pdfNumber(-scale), pdfNumber(height*scale))
+	rect := func(col color.Color, x, y, w, h float64) {
+		fmt.Fprintf(c, "%s %s %s %s %s re f\n", pdfColor(col), pdfNumber(x), pdfNumber(y), pdfNumber(w), pdfNumber(h))
+	}
+	bgDefault := color.NewFromHex(s.Background.Default)
+	if !s.Transparent {
+		rect(bgDefault, 0, 0, width, height)
+	}
+	for _, l := range s.Lines {
+		for x, ch := range l.Chars {
+			if s.Background.isDefault(ch.Background) {
+				continue
+			}
+			rect(color.NewFromHex(s.colorHex(ch.Background, &s.Background)),
+				marginX+float64(x)*cellW, marginY+float64(l.Y)*cellH, cellW, cellH)
+		}
+	}
+
+	// glyphs used by embedded font, for widths and ToUnicode
+	usedGlyphs := map[uint16]rune{}
+	fontsUsed := [4]bool{}
+
+	type run struct {
+		x     int
+		n     int
+		text  strings.Builder
+		fg    color.Color
+		font  int
+		style Char
+	}
+	for _, l := range s.Lines {
+		y := marginY + float64(l.Y)*cellH
+		var r *run
+		flush := func() {
+			if r == nil {
+				return
+			}
+			text := strings.TrimRight(r.text.String(), " ")
+			if text == "" {
+				r = nil
+				return
+			}
+			x := marginX + float64(r.x)*cellW
+			var encoded string
+			if opts.Font != nil {
+				var sb strings.Builder
+				sb.WriteByte('<')
+				for _, ru := range text {
+					gid := opts.Font.GlyphIndex(ru)
+					if gid != 0 {
+						usedGlyphs[gid] = ru
+					}
+					fmt.Fprintf(&sb, "%04x", gid)
+				}
+				sb.WriteByte('>')
+				encoded = sb.String()
+			} else {
+				var bs []byte
+				for _, ru := range text {
+					bs = append(bs, winAnsi(ru))
+				}
+				encoded = pdfString(string(bs))
+			}
+			fontsUsed[r.font] = true
+			fontName := "F1"
+			if opts.Font == nil {
+				fontName = fmt.Sprintf("F%d", r.font+1)
+			}
+
+			skew := 0.0
+			renderMode := 0
+			if opts.Font != nil {
+				// embedded font has no variants, fake italic with skew and bold with stroke
+				if r.style.Attr.Has(AttrItalic) {
+					skew = italicSlant
+				}
+				if r.style.Attr.Has(AttrIntensity) {
+					renderMode = 2
+				}
+			}
+			// text matrix flips y back and skews for italic
+			if renderMode != 0 {
+				// render mode is graphics state so save and restore it
+				c.WriteString("q ")
+			}
+			fmt.Fprintf(c, "BT /%s %s Tf %s 1 0 %s -1 %s %s Tm ", fontName, pdfNumber(fontSize), pdfColor(r.fg), pdfNumber(skew), pdfNumber(x), pdfNumber(y+baseline))
+			if renderMode != 0 {
+				fmt.Fprintf(c, "%d Tr %s w %s ", renderMode, pdfNumber(fontSize/30), strings.Replace(pdfColor(r.fg), "rg", "RG", 1))
+			}
+			fmt.Fprintf(c, "%s Tj ET", encoded)
+			if renderMode != 0 {
+				c.WriteString(" Q")
+			}
+			c.WriteString("\n")
+			r = nil
+		}
+
+		for x, ch := range l.Chars {
+			fg := color.NewFromHex(s.colorHex(ch.Foreground, &s.Foreground))
+			if ch.Attr.Has(AttrDim) {
+				bg := color.NewFromHex(s.colorHex(ch.Background, &s.Background))
+				fg = color.Color{R: (fg.R + bg.R) / 2, G: (fg.G + bg.G) / 2, B: (fg.B + bg.B) / 2}
+			}
+			font := 0
+			if ch.Attr.Has(AttrIntensity) {
+				font |= 1
+			}
+			if ch.Attr.Has(AttrItalic) {
+				font |= 2
+			}
+			if r == nil || r.fg != fg || r.font != font || r.x+r.n != x {
+				flush()
+				r = &run{x: x, fg: fg, font: font, style: ch}
+			}
+			r.text.WriteRune(ch.Rune)
+			r.n++
+
+			lineY := -1.0
+			if ch.Attr.Has(AttrUnderline) {
+				lineY = baseline + fontSize*0.1
+			} else if ch.Attr.Has(AttrStrikethrough) {
+				lineY = baseline - fontSize*0.25
+			}
+			if lineY >= 0 {
+				rect(fg, marginX+float64(x)*cellW, y+lineY, cellW, math.Max(fontSize/16, 0.5))
+			}
+		}
+		flush()
+	}
+
+	pw := &pdfWriter{}
+	version := "1.4"
+	if opts.Font != nil && opts.Font.CFF {
+		// embedded OpenType font file
+		version = "1.6"
+	}
+	fmt.Fprintf(&pw.buf, "%%PDF-%s\n%%\xe2\xe3\xcf\xd3\n", version)
+	catalog := pw.reserve()
+	pages := pw.reserve()
+	page := pw.reserve()
+	contents := pw.reserve()
+	info := pw.reserve()
+
+	var fonts []string
+	if opts.Font != nil {
+		f := opts.Font
+		font, cidFont, descriptor, fontFile, toUnicode := pw.reserve(), pw.reserve(), pw.reserve(), pw.reserve(), pw.reserve()
+		fonts = append(fonts, fmt.Sprintf("/F1 %d 0 R", font))
+		units := func(v int) string { return pdfNumber(float64(v) * 1000 / float64(f.UnitsPerEm)) }
+
+		gids := make([]int, 0, len(usedGlyphs))
+		for gid := range usedGlyphs {
+			gids = append(gids, int(gid))
+		}
+		sort.Ints(gids)
+		var widths strings.Builder
+		var cmap strings.Builder
+		defaultWidth := units(f.Advance(f.GlyphIndex('M')))
+		for _, gid := range gids {
+			if w := units(f.Advance(uint16(gid))); w != defaultWidth {
+				fmt.Fprintf(&widths, "%d [%s] ", gid, w)
+			}
+			u := []rune{usedGlyphs[uint16(gid)]}
+			var hex strings.Builder
+			for _, u16 := range utf16Encode(u) {
+				fmt.Fprintf(&hex, "%04x", u16)
+			}
+			fmt.Fprintf(&cmap, "<%04x> <%s>\n", gid, hex.String())
+		}
+
+		subtype, fontFileKey, fontFileExtra := "CIDFontType2", "FontFile2", fmt.Sprintf(" /Length1 %d", len(opts.FontData))
+		cidToGID := " /CIDToGIDMap /Identity"
+		if f.CFF {
+			subtype, fontFileKey, fontFileExtra, cidToGID = "CIDFontType0", "FontFile3", " /Subtype /OpenType", ""
+		}
+		pw.object(font, "<< /Type /Font /Subtype /Type0 /BaseFont /AnsisvgEmbedded /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>", cidFont, toUnicode)
+		pw.object(cidFont, "<< /Type /Font /Subtype /%s /BaseFont /AnsisvgEmbedded /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /DW %s /W [%s]%s >>",
+			subtype, descriptor, defaultWidth, widths.String(), cidToGID)
+		capHeight := f.CapHeight
+		if capHeight == 0 {
+			capHeight = f.Ascender
+		}
+		pw.object(descriptor, "<< /Type /FontDescriptor /FontName /AnsisvgEmbedded /Flags 33 /FontBBox [%s %s %s %s] /ItalicAngle 0 /Ascent %s /Descent %s /CapHeight %s /StemV 80 /%s %d 0 R >>",
+			units(f.XMin), units(f.YMin), units(f.XMax), units(f.YMax), units(f.Ascender), units(f.Descender), units(capHeight), fontFileKey, fontFile)
+		pw.stream(fontFile, fontFileExtra, opts.FontData)
+		pw.stream(toUnicode, "", []byte(fmt.Sprintf(`/CIDInit /ProcSet findresource begin
+12 dict begin
+begincmap
+/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def
+/CMapName /Adobe-Identity-UCS def
+/CMapType 2 def
+1 begincodespacerange
+<0000> <ffff>
+endcodespacerange
+%d beginbfchar
+%sendbfchar
+endcmap
+CMapName currentdict /CMap defineresource pop
+end
+end
+`, len(gids), cmap.String())))
+	} else {
+		for i, used := range fontsUsed {
+			if !used {
+				continue
+			}
+			n := pw.reserve()
+			fonts = append(fonts, fmt.Sprintf("/F%d %d 0 R", i+1, n))
+			pw.object(n, "<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", pdfCourierFonts[i])
+		}
+	}
+
+	pw.object(catalog, "<< /Type /Catalog /Pages %d 0 R >>", pages)
+	pw.object(pages, "<< /Type /Pages /Kids [%d 0 R] /Count 1 >>", page)
+	pw.object(page, "<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+		pages, pdfNumber(width*scale), pdfNumber(height*scale), strings.Join(fonts, " "), contents)
+	pw.stream(contents, "", c.Bytes())
+	title := ""
+	if s.Dom.Title != "" {
+		title = " /Title " + pdfTextString(s.Dom.Title)
+	}
+	pw.object(info, "<< /Producer (ansisvg)%s >>", title)
+
+	return pw.writeTo(w, catalog, info)
+}
+
+func utf16Encode(rs []rune) []uint16 {
+	var u []uint16
+	for _, r := range rs {
+		if r > 0xffff {
+			r -= 0x10000
+			u = append(u, uint16(0xd800+(r>>10)), uint16(0xdc00+(r&0x3ff)))
+			continue
+		}
+		u = append(u, uint16(r))
+	}
+	return u
+}
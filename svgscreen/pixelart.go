@@ -0,0 +1,179 @@
+package svgscreen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// each cell is pixelCols by pixelRows pixels, rows is divisible by the 2, 3
+// and 4 rows of half blocks and quadrants, sextants and braille
+const (
+	pixelCols = 2
+	pixelRows = 12
+)
+
+// pixelMask returns number of rows and mask of pixels for half block,
+// quadrant, sextant and braille chars. Rows are 2 pixels wide and bit n is
+// row n/2 and column n%2.
+func pixelMask(r rune) (int, uint8, bool) {
+	switch {
+	case r == 0x2580:
+		return 2, 0b0011, true
+	case r == 0x2584:
+		return 2, 0b1100, true
+	case r == 0x2588:
+		return 2, 0b1111, true
+	case r == 0x258c:
+		return 2, 0b0101, true
+	case r == 0x2590:
+		return 2, 0b1010, true
+	case r >= 0x2596 && r <= 0x259f:
+		var m uint8
+		for i, on := range blockQuadrants[r-0x2596] {
+			if on {
+				m |= 1 << i
+			}
+		}
+		return 2, m, true
+	case r >= 0x1fb00 && r <= 0x1fb3b:
+		// all 6 bit patterns except empty, full, left half and right half
+		// that are already in block elements
+		m := uint8(r-0x1fb00) + 1
+		if m >= 0b010101 {
+			m++
+		}
+		if m >= 0b101010 {
+			m++
+		}
+		return 3, m, true
+	case r >= 0x2800 && r <= 0x28ff:
+		// dots 1-3 and 4-6 are left and right columns of the first three
+		// rows, dots 7 and 8 are the last row
+		d := uint8(r - 0x2800)
+		var m uint8
+		for i, bit := range [8]int{0, 2, 4, 1, 3, 5, 6, 7} {
+			if d&(1<<i) != 0 {
+				m |= 1 << bit
+			}
+		}
+		return 4, m, true
+	}
+	return 0, 0, false
+}
+
+// pixelChar returns true if c is drawn as pixels
+func (s *Screen) pixelChar(c Char) bool {
+	if !s.PixelArt {
+		return false
+	}
+	_, _, ok := pixelMask(c.Rune)
+	return ok
+}
+
+// setupPixelArt draws pixel chars as rects on a grid of pixels, same colored
+// pixels are merged into rects across cells horizontally and then vertically
+func (s *Screen) setupPixelArt() {
+	if !s.PixelArt {
+		return
+	}
+
+	// class of each pixel, empty string is no pixel
+	var canvas [][]string
+	set := func(x, y int, class string) {
+		for len(canvas) <= y {
+			canvas = append(canvas, nil)
+		}
+		for len(canvas[y]) <= x {
+			canvas[y] = append(canvas[y], "")
+		}
+		canvas[y][x] = class
+	}
+	for _, l := range s.Lines {
+		for col, c := range l.Chars {
+			if !s.pixelChar(c) {
+				continue
+			}
+			rows, mask, _ := pixelMask(c.Rune)
+			class := s.styleClass(c.Attr&AttrDim, s.resolveColor(c.Foreground, &s.Foreground))
+			if class == "" {
+				// default foreground color is from the px class
+				class = " "
+			}
+			ph := pixelRows / rows
+			for i := 0; i < rows*pixelCols; i++ {
+				if mask&(1<<i) == 0 {
+					continue
+				}
+				for py := 0; py < ph; py++ {
+					set(col*pixelCols+i%pixelCols, l.Y*pixelRows+(i/pixelCols)*ph+py, class)
+				}
+			}
+		}
+	}
+	if len(canvas) == 0 {
+		return
+	}
+
+	type pixelRect struct {
+		x, y, w, h int
+		class      string
+	}
+	var rects []*pixelRect
+	// rects that ended on previous row by x, width and class
+	type runKey struct {
+		x, w  int
+		class string
+	}
+	prev := map[runKey]*pixelRect{}
+	for y, row := range canvas {
+		cur := map[runKey]*pixelRect{}
+		for x := 0; x < len(row); {
+			if row[x] == "" {
+				x++
+				continue
+			}
+			w := 1
+			for x+w < len(row) && row[x+w] == row[x] {
+				w++
+			}
+			k := runKey{x: x, w: w, class: row[x]}
+			if r, ok := prev[k]; ok {
+				r.h++
+				cur[k] = r
+			} else {
+				r := &pixelRect{x: x, y: y, w: w, h: 1, class: row[x]}
+				rects = append(rects, r)
+				cur[k] = r
+			}
+			x += w
+		}
+		prev = cur
+	}
+
+	s.Dom.PixelArt = &pixelArt{
+		X:       s.columnCoordinate(0, true),
+		Y:       s.rowCoordinate(0, true),
+		Width:   s.columnCoordinate(float32(s.TerminalWidth), false),
+		Height:  s.rowCoordinate(float32(s.NrLines), false),
+		ViewBox: fmt.Sprintf("0 0 %d %d", s.TerminalWidth*pixelCols, s.NrLines*pixelRows),
+	}
+	for _, r := range rects {
+		s.Dom.PixelArt.Rects = append(s.Dom.PixelArt.Rects, bgRect{
+			X:      fmt.Sprint(r.x),
+			Y:      fmt.Sprint(r.y),
+			Width:  fmt.Sprint(r.w),
+			Height: fmt.Sprint(r.h),
+			Color:  strings.TrimSpace(r.class),
+		})
+	}
+}
+
+// pixelArt is a nested SVG with a pixel grid as view box
+type pixelArt struct {
+	X       string
+	Y       string
+	Width   string
+	Height  string
+	ViewBox string
+	Rects   []bgRect
+}
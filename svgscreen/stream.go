@@ -0,0 +1,191 @@
+package svgscreen
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"html/template"
+	"io"
+	"os"
+)
+
+// LineWriter renders lines of a screen to SVG as they are written, memory use
+// does not depend on number of lines. SVG size and styles are known only
+// after the last line so rendered lines are kept in temporary files until
+// Render writes the SVG. Output is the same as Screen.Render.
+type LineWriter struct {
+	s      *Screen
+	t      *template.Template
+	bg     *tempFile
+	text   *tempFile
+	merger bgRectMerger
+	y      int
+	buf    bytes.Buffer
+}
+
+type tempFile struct {
+	f *os.File
+	w *bufio.Writer
+	n int64
+}
+
+func newTempFile() (*tempFile, error) {
+	f, err := os.CreateTemp("", "ansisvg-*")
+	if err != nil {
+		return nil, err
+	}
+	return &tempFile{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+func (tf *tempFile) Write(p []byte) (int, error) {
+	n, err := tf.w.Write(p)
+	tf.n += int64(n)
+	return n, err
+}
+
+// copyTo writes content of the file to w
+func (tf *tempFile) copyTo(w io.Writer) error {
+	if err := tf.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := tf.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err := io.Copy(w, tf.f)
+	return err
+}
+
+func (tf *tempFile) remove() error {
+	if err := tf.f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(tf.f.Name())
+}
+
+// NewLineWriter returns a LineWriter for screen s. Text to path, box drawing,
+// pixel art and clip to cells need all lines and are not supported.
+func (s *Screen) NewLineWriter() (*LineWriter, error) {
+	switch {
+	case s.GlyphFont != nil:
+		return nil, errors.New("line writer: text to path not supported")
+	case s.BoxDrawing:
+		return nil, errors.New("line writer: box drawing not supported")
+	case s.PixelArt:
+		return nil, errors.New("line writer: pixel art not supported")
+	case s.ClipCells:
+		return nil, errors.New("line writer: clip cells not supported")
+	}
+	if err := s.checkColors(); err != nil {
+		return nil, err
+	}
+
+	t, err := s.parseTemplate()
+	if err != nil {
+		return nil, err
+	}
+	lw := &LineWriter{s: s, t: t, merger: bgRectMerger{s: s}}
+	if lw.bg, err = newTempFile(); err != nil {
+		return nil, err
+	}
+	if lw.text, err = newTempFile(); err != nil {
+		_ = lw.bg.remove()
+		return nil, err
+	}
+	s.setupRender()
+
+	return lw, nil
+}
+
+// execute executes template name into w, minified if optimizing. Only the
+// header starts the document, other templates start after a tag so leading
+// whitespace is removed when minifying.
+func (lw *LineWriter) execute(w io.Writer, name string, data any) error {
+	if !lw.s.Optimize {
+		return lw.t.ExecuteTemplate(w, name, data)
+	}
+	lw.buf.Reset()
+	if err := lw.t.ExecuteTemplate(&lw.buf, name, data); err != nil {
+		return err
+	}
+	b := minifySVG(lw.buf.Bytes())
+	if name != "header" {
+		b = bytes.TrimLeft(b, " \t\r\n")
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// writeTag writes a tag that follows another tag
+func (lw *LineWriter) writeTag(w io.Writer, tag string) error {
+	if !lw.s.Optimize {
+		tag = "\n" + tag
+	}
+	_, err := io.WriteString(w, tag)
+	return err
+}
+
+func (lw *LineWriter) writeBgRects(rects []bgRect) error {
+	for _, r := range rects {
+		if err := lw.execute(lw.bg, "bgRect", r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteLine renders line l, lines are written in order
+func (lw *LineWriter) WriteLine(l Line) error {
+	s := lw.s
+	s.invertLine(l, s.useVariables())
+	s.enforceLineContrast(l)
+	if err := lw.writeBgRects(lw.merger.add(lw.y, l)); err != nil {
+		return err
+	}
+	lw.y++
+
+	te := s.lineToTextElement(l)
+	if len(te.TextSpans) == 0 {
+		return nil
+	}
+	s.Dom.HasText = true
+	return lw.execute(lw.text, "textElement", te)
+}
+
+// Render writes SVG of written lines to w, screen size and fonts can be set
+// after writing lines
+func (lw *LineWriter) Render(w io.Writer) error {
+	s := lw.s
+	if err := lw.writeBgRects(lw.merger.flush()); err != nil {
+		return err
+	}
+	s.setupSize()
+	s.setupStyles()
+
+	if err := lw.execute(w, "header", s); err != nil {
+		return err
+	}
+	if lw.bg.n > 0 {
+		if err := lw.execute(w, "bgGroup", s); err != nil {
+			return err
+		}
+		if err := lw.bg.copyTo(w); err != nil {
+			return err
+		}
+		if err := lw.writeTag(w, "</g>"); err != nil {
+			return err
+		}
+	}
+	if err := lw.text.copyTo(w); err != nil {
+		return err
+	}
+	return lw.writeTag(w, "</svg>\n")
+}
+
+// Close removes temporary files
+func (lw *LineWriter) Close() error {
+	bgErr := lw.bg.remove()
+	if err := lw.text.remove(); err != nil {
+		return err
+	}
+	return bgErr
+}
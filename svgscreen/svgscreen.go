@@ -2,42 +2,48 @@
 package svgscreen
 
 import (
+	"bytes"
 	_ "embed"
-	"encoding/base64"
 	"fmt"
 	"html/template"
 	"io"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/wader/ansisvg/color"
+	"github.com/wader/ansisvg/sfnt"
 	"github.com/wader/ansisvg/svgscreen/xydim"
 )
 
 //go:embed svgscreen.svg.tmpl
 var screenSVGTmpl string
 
+// Char is a cell with a rune, colors and attributes
 type Char struct {
-	Char          string
-	X             int
-	Foreground    string
-	Background    string
-	Underline     bool
-	Intensity     bool
-	Dim           bool
-	Invert        bool
-	Italic        bool
-	Strikethrough bool
+	Rune       rune
+	Foreground Color
+	Background Color
+	Attr       Attr
 }
 
+// Line is chars of a line indexed by column
 type Line struct {
 	Y     int
 	Chars []Char
 }
 
 type textSpan struct {
-	X       string
-	Class   string
-	Content string
+	X          string
+	TextLength string
+	Class      string
+	Content    string
+	// first column and number of columns of content
+	col  int
+	cols int
+	// ClipID is id of clip path of cells of span when clipping to cells
+	ClipID string
 }
 
 type textElement struct {
@@ -54,32 +60,150 @@ type bgRect struct {
 	Color  string
 }
 
+type clipRect struct {
+	ID     string
+	X      string
+	Y      string
+	Width  string
+	Height string
+}
+
 type SvgDom struct {
-	Width          string
-	Height         string
-	ViewBox        string
-	FontName       string
-	FontEmbedded   []byte
-	FontRef        string
-	FontSize       int
+	// ID, X and Y are used when screen is nested inside another document.
+	// CSS rules are scoped to ID.
+	ID           string
+	X            string
+	Y            string
+	Width        string
+	Height       string
+	ViewBox      string
+	FontName     string
+	FontEmbedded []byte
+	FontRef      string
+	// Bold, italic and bold italic faces of the same family, styles without a
+	// face are synthesized by the viewer
+	FontBold       FontFace
+	FontItalic     FontFace
+	FontBoldItalic FontFace
+	// FakeBold strokes bold text with its fill color if there is no bold face
+	FakeBold bool
+	// BoldColors are color classes used by bold text, with fake bold they
+	// get a stroke rule
+	BoldColors map[string]bool
+	FontSize   int
+	// Title and Description are rendered as <title> and <desc> and makes the
+	// SVG an accessible image labelled by them
+	Title       string
+	Description string
+	// CSS variables for scheme colors, DarkVariables are used when viewer prefers dark
+	Variables      []cssVariable
+	DarkVariables  []cssVariable
 	FgCustomColors []string
 	BgCustomColors []string
 	BgRects        []bgRect
 	TextElements   []textElement
-	ClassesUsed    struct {
+	// HasText is set if there is any text element, also when rendering lines
+	// with LineWriter
+	HasText bool
+	// Glyph outlines used instead of TextElements when Screen.GlyphFont is set
+	GlyphSymbols []glyphSymbol
+	GlyphUses    []glyphUse
+	GlyphLines   []bgRect
+	// Box drawing symbols and uses, uses are BoxWidth by BoxHeight
+	BoxSymbols []boxSymbol
+	BoxUses    []glyphUse
+	BoxWidth   string
+	BoxHeight  string
+	// Cells of text spans when clipping to cells, ids are referenced by textSpan.ClipID
+	ClipRects []clipRect
+	// Pixel rects of half block, quadrant, sextant and braille chars
+	PixelArt *pixelArt
+	// Text position from font metrics, nil uses font-relative central baseline
+	TextMetrics *textMetrics
+	ClassesUsed struct {
 		Bold          bool
 		Italic        bool
 		Underline     bool
 		Strikethrough bool
 		Dim           bool
+		Box           bool
 	}
 }
 
 type ColorMap struct {
 	Default   string
-	Custom    map[string]int
+	Custom    map[Color]int
 	ANSIUsed  [16]bool
 	DomPrefix string
+	// Inverted default color of the other color map used, only in CSS variables mode
+	InverseUsed bool
+
+	// parsed Default and class names by ANSI and custom index, cached as
+	// there are usually few colors
+	parsedDefault string
+	defaultColor  Color
+	ansiClasses   [16]string
+	customClasses []string
+}
+
+// reset resets used colors and sets class name prefix
+func (cmap *ColorMap) reset(prefix string) {
+	cmap.DomPrefix = prefix
+	cmap.Custom = map[Color]int{}
+	cmap.ANSIUsed = [16]bool{}
+	cmap.InverseUsed = false
+	cmap.ansiClasses = [16]string{}
+	cmap.customClasses = nil
+}
+
+// isDefault returns true if c is the default color or same RGB color as it
+func (cmap *ColorMap) isDefault(c Color) bool {
+	if c == ColorDefault {
+		return true
+	}
+	if cmap.parsedDefault != cmap.Default {
+		cmap.parsedDefault = cmap.Default
+		cmap.defaultColor = HexColor(cmap.Default)
+	}
+	return c.rgbColor() == cmap.defaultColor
+}
+
+// Palette is the scheme colors of a screen
+type Palette struct {
+	Foreground string
+	Background string
+	ANSIColors [16]string
+}
+
+// ANSIVariableNames are CSS variable names for ANSI colors in CSS variables mode
+var ANSIVariableNames = [16]string{
+	"ansi-black",
+	"ansi-red",
+	"ansi-green",
+	"ansi-yellow",
+	"ansi-blue",
+	"ansi-magenta",
+	"ansi-cyan",
+	"ansi-white",
+	"ansi-bright-black",
+	"ansi-bright-red",
+	"ansi-bright-green",
+	"ansi-bright-yellow",
+	"ansi-bright-blue",
+	"ansi-bright-magenta",
+	"ansi-bright-cyan",
+	"ansi-bright-white",
+}
+
+const (
+	ForegroundVariableName = "term-fg"
+	BackgroundVariableName = "term-bg"
+	DimOpacityVariableName = "term-dim-opacity"
+)
+
+type cssVariable struct {
+	Name  string
+	Value string
 }
 
 type Screen struct {
@@ -87,6 +211,11 @@ type Screen struct {
 	Foreground  ColorMap
 	Background  ColorMap
 	ANSIColors  [16]string
+	// Dark colors used when viewer prefers a dark color scheme, nil disables.
+	// Uses CSS variables for scheme colors.
+	Dark *Palette
+	// Use CSS variables with scheme colors as fallback for scheme colors and dim opacity
+	CSSVariables bool
 
 	CharacterBoxSize xydim.XyDimInt
 	MarginSize       xydim.XyDimFloat
@@ -96,211 +225,596 @@ type Screen struct {
 	NrLines          int
 	Lines            []Line
 	GridMode         bool
-	FillOnly         bool
-	Dom              SvgDom
+	// Set position of each styled run and length of runs that might not use
+	// monospace glyphs, alignment of grid mode with consolidated runs
+	HybridGridMode bool
+	// Draw box drawing, block element and Powerline chars as symbols filling
+	// the cell instead of using font glyphs
+	BoxDrawing bool
+	// Draw half block, quadrant, sextant and braille chars as merged pixel rects
+	PixelArt bool
+	// Clip each text run to its cells, icons can overflow into a following space
+	ClipCells bool
+	// Optimize for size with short class names, no whitespace and comments,
+	// no unused styles and coordinates rounded to two decimals
+	Optimize bool
+	FillOnly bool
+	// Adjust foreground colors to have at least this contrast ratio against
+	// their background, 0 disables
+	MinimumContrastRatio float64
+	// Render text as glyph outline paths from this font instead of text elements
+	GlyphFont *sfnt.Font
+	Dom       SvgDom
+
+	// set by UseFontMetrics
+	metrics *fontMetrics
+	// adjusted foreground colors by foreground and background color
+	contrastAdjusted map[[2]Color]Color
+	// text class names by attributes and color class
+	textClasses map[textClassKey]string
+	// reused buffers for content and coordinates of text spans
+	contentBuf []byte
+	xsBuf      []byte
 }
 
-func (s *Screen) columnCoordinate(col float32, addMargin bool) string {
-	unit := "ch"
+// columns converts number of columns to ch or px units
+func (s *Screen) columns(col float32) (float32, string) {
 	if s.CharacterBoxSize.X > 0 {
-		unit = "px"
-		col *= float32(s.CharacterBoxSize.X)
+		return col * float32(s.CharacterBoxSize.X), "px"
+	}
+	return col, "ch"
+}
+
+// rows converts number of rows to em or px units
+func (s *Screen) rows(row float32) (float32, string) {
+	if s.CharacterBoxSize.Y > 0 {
+		return row * float32(s.CharacterBoxSize.Y), "px"
 	}
+	// Apply line height multiplier for em units
+	return row * s.LineHeight, "em"
+}
+
+func (s *Screen) columnCoordinate(col float32, addMargin bool) string {
+	v, unit := s.columns(col)
 	if addMargin {
-		col += s.MarginSize.X
+		v += s.MarginSize.X
 	}
-	return fmt.Sprintf("%g%s", col, unit)
+	return s.coordinate(v, unit)
+}
+
+// appendColumnCoordinate appends coordinate of column col including margin to b
+func (s *Screen) appendColumnCoordinate(b []byte, col int) []byte {
+	v, unit := s.columns(float32(col))
+	return s.appendCoordinate(b, v+s.MarginSize.X, unit)
 }
 
 func (s *Screen) rowCoordinate(row float32, addMargin bool) string {
-	unit := "em"
-	if s.CharacterBoxSize.Y > 0 {
-		unit = "px"
-		row *= float32(s.CharacterBoxSize.Y)
-	} else {
-		// Apply line height multiplier for em units
-		row *= s.LineHeight
-	}
+	v, unit := s.rows(row)
 	if addMargin {
-		row += s.MarginSize.Y
+		v += s.MarginSize.Y
 	}
-	return fmt.Sprintf("%g%s", row, unit)
+	return s.coordinate(v, unit)
 }
 
-// Resolve color from string (either # prefixed hex value or index into lookup table)
-func (s *Screen) resolveColor(c string, cmap *ColorMap) string {
-	if c == "" || c == cmap.Default {
+// size returns SVG width and height including margins and their units
+func (s *Screen) size() (w float32, h float32, xUnit string, yUnit string) {
+	if s.CharacterBoxSize.X == 0 {
+		// Font-relative coordinates
+		w, xUnit = s.columns(float32(s.TerminalWidth) + 2*s.MarginSize.X)
+		h, yUnit = s.rows(float32(s.NrLines) + 2*s.MarginSize.Y)
+		return w, h, xUnit, yUnit
+	}
+	// Pixel coordinates
+	w = float32(s.CharacterBoxSize.X*s.TerminalWidth) + 2*s.MarginSize.X
+	h = float32(s.CharacterBoxSize.Y*s.NrLines) + 2*s.MarginSize.Y
+	return w, h, "px", "px"
+}
+
+// Resolve color to class name, "" for default color. Used colors are
+// remembered for the style sheet.
+func (s *Screen) resolveColor(c Color, cmap *ColorMap) string {
+	if cmap.isDefault(c) {
 		return ""
 	}
+	if c == colorDefaultForeground || c == colorDefaultBackground {
+		cmap.InverseUsed = true
+		return cmap.DomPrefix + "d"
+	}
 
-	if !strings.HasPrefix(c, "#") {
+	if idx, ok := c.ANSI(); ok && idx < 16 {
 		// standard ANSI color
-		idx, _ := strconv.Atoi(c)
 		cmap.ANSIUsed[idx] = true
-		return cmap.DomPrefix + "a" + c
+		if cmap.ansiClasses[idx] == "" {
+			cmap.ansiClasses[idx] = s.colorClass(cmap.DomPrefix+"a", idx)
+		}
+		return cmap.ansiClasses[idx]
 	}
-	// custom color. update lookup table if necessary
+	// custom color. update lookup table if necessary, 256 colors share
+	// class with same RGB color
+	c = c.rgbColor()
 	colIdx, present := cmap.Custom[c]
-	if present {
-		return cmap.DomPrefix + "c" + strconv.Itoa(colIdx)
+	if !present {
+		colIdx = len(cmap.Custom)
+		cmap.Custom[c] = colIdx
+		cmap.customClasses = append(cmap.customClasses, s.colorClass(cmap.DomPrefix+"c", colIdx))
 	}
-	colIdx = len(cmap.Custom)
-	cmap.Custom[c] = colIdx
-	return cmap.DomPrefix + "c" + strconv.Itoa(colIdx)
+	return cmap.customClasses[colIdx]
 }
 
-func (s *Screen) charToFgText(c Char) textSpan {
-	var classes []string
+// textClassKey is text attributes and color class of a char
+type textClassKey struct {
+	attr  Attr
+	color string
+}
 
-	if c.Intensity {
-		classes = append(classes, "bold")
-		s.Dom.ClassesUsed.Bold = true
+// charClass returns class of text of c, class names are cached by
+// attributes and color so there are no allocations per char
+func (s *Screen) charClass(c Char) string {
+	if s.pixelChar(c) {
+		// pixels are not text
+		return ""
 	}
-	if c.Dim {
-		classes = append(classes, "dim")
-		s.Dom.ClassesUsed.Dim = true
+	if _, ok := s.boxChar(c); ok {
+		// keep in text for copy and paste but hidden
+		s.Dom.ClassesUsed.Box = true
+		return s.class("bx")
 	}
-	if c.Italic {
-		classes = append(classes, "italic")
-		s.Dom.ClassesUsed.Italic = true
+
+	attr := c.Attr & (AttrIntensity | AttrDim | AttrItalic | AttrUnderline | AttrStrikethrough)
+	if attr.Has(AttrUnderline) {
+		attr &^= AttrStrikethrough
 	}
-	if c.Underline {
-		classes = append(classes, "underline")
-		s.Dom.ClassesUsed.Underline = true
-	} else if c.Strikethrough {
-		classes = append(classes, "strikethrough")
-		s.Dom.ClassesUsed.Strikethrough = true
+	return s.styleClass(attr, s.resolveColor(c.Foreground, &s.Foreground))
+}
+
+// styleClass returns class names for attributes and a color class
+func (s *Screen) styleClass(attr Attr, color string) string {
+	k := textClassKey{attr: attr, color: color}
+	if class, ok := s.textClasses[k]; ok {
+		return class
 	}
 
-	color := s.resolveColor(c.Foreground, &s.Foreground)
-	if color != "" {
-		classes = append(classes, color)
+	var classes []string
+	for _, a := range []struct {
+		attr Attr
+		name string
+		used *bool
+	}{
+		{AttrIntensity, "bold", &s.Dom.ClassesUsed.Bold},
+		{AttrDim, "dim", &s.Dom.ClassesUsed.Dim},
+		{AttrItalic, "italic", &s.Dom.ClassesUsed.Italic},
+		{AttrUnderline, "underline", &s.Dom.ClassesUsed.Underline},
+		{AttrStrikethrough, "strikethrough", &s.Dom.ClassesUsed.Strikethrough},
+	} {
+		if attr.Has(a.attr) {
+			classes = append(classes, s.class(a.name))
+			*a.used = true
+		}
 	}
+	if k.color != "" {
+		classes = append(classes, k.color)
+		if attr.Has(AttrIntensity) {
+			if s.Dom.BoldColors == nil {
+				s.Dom.BoldColors = map[string]bool{}
+			}
+			s.Dom.BoldColors[k.color] = true
+		}
+	}
+	class := strings.Join(classes, " ")
+	if s.textClasses == nil {
+		s.textClasses = map[textClassKey]string{}
+	}
+	s.textClasses[k] = class
+	return class
+}
 
-	return textSpan{
-		Class:   strings.Join(classes, " "),
-		Content: c.Char,
+// PlainText returns text content of lines, one line per row with trailing
+// whitespace removed, same text as selecting and copying rendered text
+func PlainText(lines []Line) string {
+	var rows []string
+	for _, l := range lines {
+		for len(rows) <= l.Y {
+			rows = append(rows, "")
+		}
+		var sb strings.Builder
+		for _, c := range l.Chars {
+			sb.WriteRune(c.Rune)
+		}
+		rows[l.Y] = strings.TrimRight(sb.String(), " ")
 	}
+	for len(rows) > 0 && rows[len(rows)-1] == "" {
+		rows = rows[:len(rows)-1]
+	}
+	return strings.Join(rows, "\n")
 }
 
-// Convert a line into a textElement
-// fc gives textSpan for a single char
+// Convert a line into a textElement, spans are built in reused buffers so
+// there are allocations per span but not per char
 func (s *Screen) lineToTextElement(l Line) textElement {
 	var t []textSpan
-	currentSpan := textSpan{
-		Class:   "",
-		Content: "",
-	}
+	var currentSpan textSpan
+	content := s.contentBuf[:0]
+	xs := s.xsBuf[:0]
+	// first char of current span can be positioned in a list of coordinates
+	currentGrid := false
 
 	appendSpan := func() {
-		if currentSpan.Content == "" {
+		if len(content) == 0 {
 			return
 		}
+		currentSpan.Content = string(content)
+		switch {
+		case s.GridMode:
+			currentSpan.X = string(xs)
+		case s.HybridGridMode || s.ClipCells:
+			currentSpan.X = s.columnCoordinate(float32(currentSpan.col), true)
+		}
+		if s.HybridGridMode && currentSpan.cols > 1 && driftProne(currentSpan.Content) {
+			w, unit := s.columns(float32(currentSpan.cols))
+			currentSpan.TextLength = s.coordinate(w, unit)
+		}
 		t = append(t, currentSpan)
 	}
+	prevIcon := false
 	for col, c := range l.Chars {
-		newSpan := s.charToFgText(c)
+		class := s.charClass(c)
+		r := c.Rune
+		if s.pixelChar(c) {
+			r = ' '
+		}
+		// when clipping, icons can only overflow into following whitespace
+		afterIcon := prevIcon && s.ClipCells
+		prevIcon = isIcon(r)
 		if s.GridMode {
-			// In grid mode, set X coordinate for each text span
-			newSpan.X = s.columnCoordinate(float32(col), true)
-			// In grid mode, we never consolidate
+			// In grid mode, set X coordinate for each character. Runs of chars
+			// with the same class are consolidated into one span with a list of
+			// X coordinates, one per char.
+			if len(content) > 0 && class == currentSpan.Class && gridChar(r) && currentGrid {
+				xs = append(xs, ' ')
+				xs = s.appendColumnCoordinate(xs, col)
+				content = utf8.AppendRune(content, r)
+				currentSpan.cols++
+				continue
+			}
 			appendSpan()
-			currentSpan = newSpan
+			currentSpan = textSpan{Class: class, col: col, cols: 1}
+			content = utf8.AppendRune(content[:0], r)
+			xs = s.appendColumnCoordinate(xs[:0], col)
+			currentGrid = gridChar(r)
 			continue
 		}
 		// Don't consolidate if class is changing, but ignore whitespace
-		if newSpan.Class != currentSpan.Class && strings.TrimSpace(newSpan.Content) != "" {
+		if (class != currentSpan.Class || afterIcon) && !unicode.IsSpace(r) {
 			appendSpan()
-			currentSpan = newSpan
+			currentSpan = textSpan{Class: class, col: col, cols: 1}
+			content = utf8.AppendRune(content[:0], r)
 			continue
 		}
 		// Consolidate new content with previous one.
-		currentSpan.Content += newSpan.Content
+		content = utf8.AppendRune(content, r)
+		currentSpan.cols++
 	}
 	appendSpan()
+	s.contentBuf = content
+	s.xsBuf = xs
 
 	// remove trailing whitespace
 	for len(t) > 0 && strings.TrimSpace(t[len(t)-1].Content) == "" {
 		t = t[:len(t)-1]
 	}
+	if s.HybridGridMode && !s.ClipCells && len(t) > 0 && t[0].X == s.columnCoordinate(0, true) {
+		// same as text x
+		t[0].X = ""
+	}
+	if s.ClipCells {
+		for i := range t {
+			t[i].ClipID = "k" + strconv.Itoa(len(s.Dom.ClipRects))
+			s.Dom.ClipRects = append(s.Dom.ClipRects, clipRect{
+				ID:     t[i].ClipID,
+				X:      s.columnCoordinate(float32(t[i].col), true),
+				Y:      s.rowCoordinate(float32(l.Y), true),
+				Width:  s.columnCoordinate(float32(t[i].cols), false),
+				Height: s.rowCoordinate(1, false),
+			})
+		}
+	}
+
+	y := s.rowCoordinate(float32(l.Y)+0.5, true)
+	if s.Dom.TextMetrics != nil {
+		y = s.rowCoordinate(float32(l.Y)+s.Dom.TextMetrics.baseline, true)
+	}
 
 	return textElement{
 		X:         s.columnCoordinate(0, true),
-		Y:         s.rowCoordinate(float32(l.Y)+0.5, true),
+		Y:         y,
 		TextSpans: t,
 	}
 }
 
-func (s *Screen) handleColorInversion() {
+// isIcon returns true if r is in a private use area, where Nerd Fonts and
+// Powerline put icons that often are wider than a cell
+func isIcon(r rune) bool {
+	return (r >= 0xe000 && r <= 0xf8ff) || r >= 0xf0000
+}
+
+// gridChar returns true if r is a single UTF-16 code unit so it can be
+// positioned by one coordinate in a list
+func gridChar(r rune) bool {
+	return r <= 0xffff
+}
+
+// driftProne returns true if text might be rendered with glyphs that are not
+// 1ch wide, ASCII is assumed to be in the monospace font
+func driftProne(text string) bool {
+	for _, r := range text {
+		if r >= 0x80 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Screen) useVariables() bool {
+	return s.Dark != nil || s.CSSVariables
+}
+
+// setupVariables sets up CSS variable definitions for used scheme colors, only
+// needed for dark mode, in CSS variables mode they are set by the user
+func (s *Screen) setupVariables() {
+	if s.Dark == nil {
+		return
+	}
+	variables := func(p Palette) []cssVariable {
+		vs := []cssVariable{
+			{Name: ForegroundVariableName, Value: p.Foreground},
+			{Name: BackgroundVariableName, Value: p.Background},
+		}
+		for i, c := range p.ANSIColors {
+			if s.Foreground.ANSIUsed[i] || s.Background.ANSIUsed[i] {
+				vs = append(vs, cssVariable{Name: ANSIVariableNames[i], Value: c})
+			}
+		}
+		return vs
+	}
+	s.Dom.Variables = variables(Palette{
+		Foreground: s.Foreground.Default,
+		Background: s.Background.Default,
+		ANSIColors: s.ANSIColors,
+	})
+	s.Dom.DarkVariables = variables(*s.Dark)
+}
+
+// checkColors returns error if a scheme color is not #rrggbb, scheme colors
+// are written as is to CSS
+func (s *Screen) checkColors() error {
+	palettes := []Palette{{
+		Foreground: s.Foreground.Default,
+		Background: s.Background.Default,
+		ANSIColors: s.ANSIColors,
+	}}
+	if s.Dark != nil {
+		palettes = append(palettes, *s.Dark)
+	}
+	for _, p := range palettes {
+		for _, c := range append([]string{p.Foreground, p.Background}, p.ANSIColors[:]...) {
+			if HexColor(c) == ColorDefault {
+				return fmt.Errorf("%q: color must be #rrggbb", c)
+			}
+		}
+	}
+	return nil
+}
+
+// cssValue returns value or a CSS variable with value as fallback in variables mode,
+// colors are checked by checkColors
+func (s *Screen) cssValue(name string, c string) template.CSS {
+	if !s.useVariables() {
+		return template.CSS(c) //nolint:gosec
+	}
+	return template.CSS(fmt.Sprintf("var(--%s, %s)", name, c)) //nolint:gosec
+}
+
+func (s *Screen) handleColorInversion(defaultMarkers bool) {
 	for _, l := range s.Lines {
-		for i, c := range l.Chars {
-			if c.Invert {
-				c.Background, c.Foreground = c.Foreground, c.Background
-				if c.Background == "" {
-					c.Background = s.Foreground.Default
+		s.invertLine(l, defaultMarkers)
+	}
+}
+
+// invertLine swaps foreground and background colors of inverted chars. Swapped
+// default colors are markers if defaultMarkers is set, ex when default colors
+// are CSS variables, otherwise the default color of the other color map.
+func (s *Screen) invertLine(l Line, defaultMarkers bool) {
+	for i, c := range l.Chars {
+		if c.Attr.Has(AttrInvert) {
+			c.Background, c.Foreground = c.Foreground, c.Background
+			if c.Background == ColorDefault {
+				c.Background = HexColor(s.Foreground.Default)
+				if defaultMarkers {
+					c.Background = colorDefaultForeground
 				}
-				if c.Foreground == "" {
-					c.Foreground = s.Background.Default
+			}
+			if c.Foreground == ColorDefault {
+				c.Foreground = HexColor(s.Background.Default)
+				if defaultMarkers {
+					c.Foreground = colorDefaultBackground
 				}
-				l.Chars[i] = c
-				c.Invert = false
 			}
+			l.Chars[i] = c
+		}
+	}
+}
+
+// Resolve color to hex color, ColorDefault is default color of cmap
+func (s *Screen) colorHex(c Color, cmap *ColorMap) string {
+	switch c {
+	case ColorDefault:
+		return cmap.Default
+	case colorDefaultForeground:
+		return s.Foreground.Default
+	case colorDefaultBackground:
+		return s.Background.Default
+	}
+	if idx, ok := c.ANSI(); ok {
+		return s.ANSIColors[idx]
+	}
+	return c.Hex()
+}
+
+func (s *Screen) enforceMinimumContrast() {
+	for _, l := range s.Lines {
+		s.enforceLineContrast(l)
+	}
+}
+
+// enforceLineContrast adjusts foreground colors of a line to minimum contrast
+func (s *Screen) enforceLineContrast(l Line) {
+	if s.MinimumContrastRatio <= 0 {
+		return
+	}
+	if s.contrastAdjusted == nil {
+		s.contrastAdjusted = map[[2]Color]Color{}
+	}
+	for i, c := range l.Chars {
+		k := [2]Color{c.Foreground, c.Background}
+		a, ok := s.contrastAdjusted[k]
+		if !ok {
+			fg := s.colorHex(c.Foreground, &s.Foreground)
+			bg := s.colorHex(c.Background, &s.Background)
+			a = c.Foreground
+			if h := color.NewFromHex(fg).EnsureContrast(color.NewFromHex(bg), s.MinimumContrastRatio).Hex(); h != fg {
+				a = HexColor(h)
+			}
+			s.contrastAdjusted[k] = a
 		}
+		l.Chars[i].Foreground = a
 	}
 }
 
 func (s *Screen) setupBgRects() {
-	// Set up background rects
+	m := bgRectMerger{s: s}
 	for y, l := range s.Lines {
-		type tmpRect struct {
-			x     int
-			w     int
-			color string
+		s.Dom.BgRects = append(s.Dom.BgRects, m.add(y, l)...)
+	}
+	s.Dom.BgRects = append(s.Dom.BgRects, m.flush()...)
+}
+
+// bgRectMerger sets up background rects line by line, same colored cells are
+// merged horizontally and then rects with same column and width are merged
+// across lines. Rects are returned when they end so only rects of the last
+// line are kept.
+type bgRectMerger struct {
+	s *Screen
+	// rects of last added line in order of creation and by column, width
+	// and color
+	open   []*tmpBgRect
+	openBy map[bgRectKey]*tmpBgRect
+}
+
+type bgRectKey struct {
+	x     int
+	w     int
+	color string
+}
+
+type tmpBgRect struct {
+	x     int
+	y     int
+	w     int
+	h     int
+	color string
+}
+
+// add adds line with index y and returns rects that ended on the line before
+func (m *bgRectMerger) add(y int, l Line) []bgRect {
+	var cur []*tmpBgRect
+	curBy := map[bgRectKey]*tmpBgRect{}
+	currentRect := tmpBgRect{x: 0, w: 0, color: ""}
+
+	appendRect := func() {
+		if currentRect.color == "" {
+			return
+		}
+		k := bgRectKey{x: currentRect.x, w: currentRect.w, color: currentRect.color}
+		if r, ok := m.openBy[k]; ok && r.y+r.h == y {
+			r.h++
+			cur = append(cur, r)
+			curBy[k] = r
+			return
+		}
+		r := currentRect
+		r.y = y
+		r.h = 1
+		cur = append(cur, &r)
+		curBy[k] = &r
+	}
+	for x, c := range l.Chars {
+		if m.s.Background.isDefault(c.Background) {
+			continue
 		}
-		currentRect := tmpRect{x: 0, w: 0, color: ""}
+		newRect := tmpBgRect{x: x, w: 1, color: m.s.resolveColor(c.Background, &m.s.Background)}
 
-		appendRect := func() {
-			if currentRect.color == "" {
-				return
-			}
-			s.Dom.BgRects = append(s.Dom.BgRects, bgRect{
-				X:      s.columnCoordinate(float32(currentRect.x), true),
-				Y:      s.rowCoordinate(float32(y), true),
-				Width:  s.columnCoordinate(float32(currentRect.w), false),
-				Height: s.rowCoordinate(1, false),
-				Color:  currentRect.color,
-			})
+		if newRect.x != (currentRect.x+currentRect.w) || newRect.color != currentRect.color {
+			appendRect()
+			currentRect = newRect
+			continue
 		}
-		for x, c := range l.Chars {
-			if c.Background == "" || c.Background == s.Background.Default {
-				continue
-			}
-			newRect := tmpRect{x: x, w: 1, color: s.resolveColor(c.Background, &s.Background)}
 
-			if newRect.x != (currentRect.x+currentRect.w) || newRect.color != currentRect.color {
-				appendRect()
-				currentRect = newRect
-				continue
-			}
+		currentRect.w += 1
+	}
+	appendRect()
 
-			currentRect.w += 1
+	var ended []bgRect
+	for _, r := range m.open {
+		if curBy[bgRectKey{x: r.x, w: r.w, color: r.color}] != r {
+			ended = append(ended, m.bgRect(r))
 		}
-		appendRect()
+	}
+	m.open = cur
+	m.openBy = curBy
+	return ended
+}
+
+// flush returns rects that are still open
+func (m *bgRectMerger) flush() []bgRect {
+	var rects []bgRect
+	for _, r := range m.open {
+		rects = append(rects, m.bgRect(r))
+	}
+	m.open = nil
+	m.openBy = nil
+	return rects
+}
+
+func (m *bgRectMerger) bgRect(r *tmpBgRect) bgRect {
+	return bgRect{
+		X:      m.s.columnCoordinate(float32(r.x), true),
+		Y:      m.s.rowCoordinate(float32(r.y), true),
+		Width:  m.s.columnCoordinate(float32(r.w), false),
+		Height: m.s.rowCoordinate(float32(r.h), false),
+		Color:  r.color,
 	}
 }
 
-func setupCustomColors(revLookup map[string]int, clsTable *[]string) {
+func setupCustomColors(revLookup map[Color]int, clsTable *[]string) {
 	result := make([]string, len(revLookup))
 	for k, v := range revLookup {
-		result[v] = k
+		result[v] = k.Hex()
 	}
 	*clsTable = result
 }
 
-func (s *Screen) Render(w io.Writer) error {
-	t := template.New("")
-	t.Funcs(template.FuncMap{
-		"base64": func(bs []byte) string { return base64.RawStdEncoding.EncodeToString(bs) },
+func (s *Screen) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"cssValue":         s.cssValue,
+		"class":            s.class,
+		"colorClass":       s.colorClass,
+		"fontDataURL":      fontDataURL,
+		"fontFormat":       func(bs []byte) string { _, f := fontType(bs); return f },
+		"fontRefFormat":    fontRefFormat,
+		"ansiVariableName": func(i int) string { return ANSIVariableNames[i] },
+		"fgVariableName":   func() string { return ForegroundVariableName },
+		"bgVariableName":   func() string { return BackgroundVariableName },
+		"dimVariableName":  func() string { return DimOpacityVariableName },
 		"anyColorUsed": func(arr [16]bool) bool {
 			for _, value := range arr {
 				if value {
@@ -309,47 +823,84 @@ func (s *Screen) Render(w io.Writer) error {
 			}
 			return false
 		},
-	})
+	}
+}
 
-	s.Foreground.DomPrefix = "f"
-	s.Background.DomPrefix = "b"
-	s.Foreground.Custom = map[string]int{}
-	s.Background.Custom = map[string]int{}
+// setupRender sets up color maps and text position, done before adding lines
+func (s *Screen) setupRender() {
+	s.Foreground.reset("f")
+	s.Background.reset("b")
 
-	// Set SVG size
-	if s.CharacterBoxSize.X == 0 {
-		// Font-relative coordinates
-		s.Dom.Width = s.columnCoordinate(float32(s.TerminalWidth)+2*s.MarginSize.X, false)
-		s.Dom.Height = s.rowCoordinate(float32(s.NrLines)+2*s.MarginSize.Y, false)
-		s.Dom.ViewBox = ""
+	if s.GlyphFont != nil {
+		s.UseFontMetrics(s.GlyphFont)
 	} else {
-		// Pixel coordinates
-		w := float32(s.CharacterBoxSize.X*s.TerminalWidth) + 2*s.MarginSize.X
-		h := float32(s.CharacterBoxSize.Y*s.NrLines) + 2*s.MarginSize.Y
-		s.Dom.Width = fmt.Sprintf("%gpx", w)
-		s.Dom.Height = fmt.Sprintf("%gpx", h)
-		s.Dom.ViewBox = fmt.Sprintf("0 0 %g %g", w, h)
+		s.setupTextMetrics()
 	}
+}
 
-	s.handleColorInversion()
+// setupSize sets SVG size from terminal width and number of lines
+func (s *Screen) setupSize() {
+	width, height, xUnit, yUnit := s.size()
+	s.Dom.Width = s.coordinate(width, xUnit)
+	s.Dom.Height = s.coordinate(height, yUnit)
+	s.Dom.ViewBox = ""
+	if s.CharacterBoxSize.X != 0 {
+		s.Dom.ViewBox = fmt.Sprintf("0 0 %g %g", width, height)
+	}
+}
+
+// setupStyles sets up styles of colors used by added lines
+func (s *Screen) setupStyles() {
+	s.setupVariables()
+	setupCustomColors(s.Foreground.Custom, &s.Dom.FgCustomColors)
+	setupCustomColors(s.Background.Custom, &s.Dom.BgCustomColors)
+}
+
+func (s *Screen) parseTemplate() (*template.Template, error) {
+	return template.New("").Funcs(s.templateFuncs()).Parse(screenSVGTmpl)
+}
+
+func (s *Screen) Render(w io.Writer) error {
+	if err := s.checkColors(); err != nil {
+		return err
+	}
+	s.setupRender()
+	s.setupSize()
+
+	s.handleColorInversion(s.useVariables())
+	s.enforceMinimumContrast()
 	s.setupBgRects()
+	s.setupBoxDrawing()
+	s.setupPixelArt()
 
-	// Set up text elements
-	for _, l := range s.Lines {
-		fg := s.lineToTextElement(l)
-		if len(fg.TextSpans) > 0 {
-			s.Dom.TextElements = append(s.Dom.TextElements, fg)
+	if s.GlyphFont != nil {
+		s.setupGlyphs()
+	} else {
+		// Set up text elements
+		for _, l := range s.Lines {
+			fg := s.lineToTextElement(l)
+			if len(fg.TextSpans) > 0 {
+				s.Dom.TextElements = append(s.Dom.TextElements, fg)
+				s.Dom.HasText = true
+			}
 		}
 	}
 
-	setupCustomColors(s.Foreground.Custom, &s.Dom.FgCustomColors)
-	setupCustomColors(s.Background.Custom, &s.Dom.BgCustomColors)
+	s.setupStyles()
 
 	// Create SVG from template
-	t, err := t.Parse(screenSVGTmpl)
+	t, err := s.parseTemplate()
 	if err != nil {
 		return err
 	}
+	if s.Optimize {
+		b := &bytes.Buffer{}
+		if err = t.ExecuteTemplate(b, "", s); err != nil {
+			return err
+		}
+		_, err = w.Write(minifySVG(b.Bytes()))
+		return err
+	}
 	if err = t.ExecuteTemplate(w, "", s); err != nil {
 		return err
 	}
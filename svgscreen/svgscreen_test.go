@@ -0,0 +1,150 @@
+package svgscreen
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// styledLine returns a line with spans of spanLen chars, each with a different
+// style than the one before
+func styledLine(spans int, spanLen int) Line {
+	styles := []Char{
+		{Foreground: ANSIColor(1)},
+		{Foreground: ANSIColor(2), Attr: AttrIntensity},
+		{Foreground: RGBColor(255, 128, 0), Background: ANSIColor(4)},
+		{Attr: AttrItalic | AttrUnderline},
+		{Foreground: ANSIColor(9), Attr: AttrDim},
+	}
+	var l Line
+	for i := 0; i < spans; i++ {
+		c := styles[i%len(styles)]
+		for j := 0; j < spanLen; j++ {
+			c.Rune = rune('a' + j%26)
+			l.Chars = append(l.Chars, c)
+		}
+	}
+	return l
+}
+
+func newTestScreen(gridMode bool) *Screen {
+	s := &Screen{
+		Foreground: ColorMap{Default: "#bbbbbb"},
+		Background: ColorMap{Default: "#000000"},
+		LineHeight: 1,
+		GridMode:   gridMode,
+	}
+	s.setupRender()
+	return s
+}
+
+// TestLineToTextElementAllocs makes sure allocations depend on number of spans
+// and not on number of chars
+func TestLineToTextElementAllocs(t *testing.T) {
+	for _, gridMode := range []bool{false, true} {
+		gridMode := gridMode
+		t.Run(fmt.Sprintf("grid=%t", gridMode), func(t *testing.T) {
+			allocs := func(spanLen int) float64 {
+				s := newTestScreen(gridMode)
+				l := styledLine(50, spanLen)
+				return testing.AllocsPerRun(10, func() { s.lineToTextElement(l) })
+			}
+			short, long := allocs(2), allocs(200)
+			if short != long {
+				t.Errorf("expected same allocations for 2 and 200 chars per span, got %v and %v", short, long)
+			}
+		})
+	}
+}
+
+func BenchmarkLineToTextElement(b *testing.B) {
+	for _, gridMode := range []bool{false, true} {
+		b.Run(fmt.Sprintf("grid=%t", gridMode), func(b *testing.B) {
+			s := newTestScreen(gridMode)
+			l := styledLine(100, 20)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.lineToTextElement(l)
+			}
+		})
+	}
+}
+
+// svgTexts returns text content of each text element
+func svgTexts(t *testing.T, b []byte) []string {
+	var texts []string
+	var inText bool
+	d := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return texts
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if tok.Name.Local == "text" {
+				inText = true
+				texts = append(texts, "")
+			}
+		case xml.EndElement:
+			if tok.Name.Local == "text" {
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				texts[len(texts)-1] += string(tok)
+			}
+		}
+	}
+}
+
+// TestOptimizeKeepsText makes sure minifying never changes text content
+func TestOptimizeKeepsText(t *testing.T) {
+	lines := []string{
+		"a  >  b",
+		"<!-- c -->  <style> d { e: f; } </style>",
+		`style="fill: red" >`,
+		">\t<  >",
+		"  \"g\" & 'h' ",
+	}
+	for _, optimize := range []bool{false, true} {
+		s := &Screen{
+			Foreground: ColorMap{Default: "#bbbbbb"},
+			Background: ColorMap{Default: "#000000"},
+			LineHeight: 1,
+			Optimize:   optimize,
+			NrLines:    len(lines),
+		}
+		for i := range s.ANSIColors {
+			s.ANSIColors[i] = "#aa0000"
+		}
+		for i, l := range lines {
+			var line Line
+			for _, r := range l {
+				c := Char{Rune: r}
+				if i%2 == 1 {
+					c.Foreground = ANSIColor(1)
+				}
+				line.Chars = append(line.Chars, c)
+			}
+			s.Lines = append(s.Lines, line)
+			if len(l) > s.Columns {
+				s.Columns = len(l)
+			}
+		}
+		b := &bytes.Buffer{}
+		if err := s.Render(b); err != nil {
+			t.Fatal(err)
+		}
+		actual := svgTexts(t, b.Bytes())
+		if !reflect.DeepEqual(lines, actual) {
+			t.Errorf("optimize=%t: expected texts %q, got %q", optimize, lines, actual)
+		}
+	}
+}